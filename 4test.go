@@ -1,4 +1,4 @@
-// v0.9.1
+// v0.10.0
 // Author: DIEHL E.
 // © Sony Pictures Entertainment, Nov 2024
 
@@ -28,7 +28,9 @@ func CompareFiles(f1 string, f2 string) bool {
 
 // Describe displays the order of the test, the name of the function and its optional description provided by 'msg'.
 // It initializes an assert and require and returns them.
+// It attaches to the test its generator (see Gen) so that, if the test fails, the seed to replay it is logged.
 func Describe(t *testing.T, msg ...string) (*require.Assertions, *assert.Assertions) {
+	Gen(t)
 
 	dispMsg := ""
 	if len(msg) != 0 {
@@ -0,0 +1,387 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// ArchiveFormat is the format of an archive generated by RandomArchive.
+type ArchiveFormat int
+
+const (
+	// Zip is the zip format.
+	Zip ArchiveFormat = iota
+	// Tar is the tar format.
+	Tar
+	// TarGz is the tar format compressed by gzip.
+	TarGz
+)
+
+// ArchiveEntry describes an entry of an archive.
+type ArchiveEntry struct {
+	// Name is the slash-separated name of the entry.
+	Name string
+	// Mode holds the type and the permissions of the entry.
+	Mode os.FileMode
+	// Data is the content of a regular file.
+	Data []byte
+	// Target is the target of a symbolic link.
+	Target string
+	// DeclaredSize is the size declared by the header when it differs from the size of Data.  With
+	// tar, a larger size ends the archive within the data of the entry, and a smaller size leaves the
+	// extra data where the reader expects the next header.
+	DeclaredSize int64
+	// BadChecksum is true if the checksum of the entry is wrong.
+	BadChecksum bool
+}
+
+// ArchiveOption allows to parameterize RandomArchive.
+type ArchiveOption func(opts *archiveOptions)
+
+type archiveOptions struct {
+	entries      []ArchiveEntry
+	root         string
+	traversal    bool
+	absolute     bool
+	duplicates   bool
+	symlinks     bool
+	declaredSize int64
+	badChecksums bool
+}
+
+// WithArchiveEntries sets the entries of the archive instead of random ones.
+func WithArchiveEntries(entries ...ArchiveEntry) ArchiveOption {
+	return func(ao *archiveOptions) {
+		ao.entries = entries
+	}
+}
+
+// WithArchiveTree archives the tree under `root`, for instance created by RandomTree, instead of
+// random entries.
+func WithArchiveTree(root string) ArchiveOption {
+	return func(ao *archiveOptions) {
+		ao.root = root
+	}
+}
+
+// WithPathTraversal adds an entry which name escapes the extraction directory with "../".
+func WithPathTraversal() ArchiveOption {
+	return func(ao *archiveOptions) {
+		ao.traversal = true
+	}
+}
+
+// WithAbsolutePaths adds an entry with an absolute name.
+func WithAbsolutePaths() ArchiveOption {
+	return func(ao *archiveOptions) {
+		ao.absolute = true
+	}
+}
+
+// WithDuplicates adds a second entry with the name of a file but another content.
+func WithDuplicates() ArchiveOption {
+	return func(ao *archiveOptions) {
+		ao.duplicates = true
+	}
+}
+
+// WithOutsideSymlinks adds symbolic links pointing outside the tree, with a relative and an absolute
+// target.
+func WithOutsideSymlinks() ArchiveOption {
+	return func(ao *archiveOptions) {
+		ao.symlinks = true
+	}
+}
+
+// WithHugeDeclaredSize adds a small entry which header declares `size` bytes, as a zip bomb would.
+// With tar, the archive ends within the data of this entry.
+func WithHugeDeclaredSize(size int64) ArchiveOption {
+	return func(ao *archiveOptions) {
+		ao.declaredSize = size
+	}
+}
+
+// WithBadChecksums corrupts the checksums: the CRC-32 of the zip entries or the header checksum of
+// the first tar entry.
+func WithBadChecksums() ArchiveOption {
+	return func(ao *archiveOptions) {
+		ao.badChecksums = true
+	}
+}
+
+func collectArchiveOptions(options ...ArchiveOption) *archiveOptions {
+	opts := &archiveOptions{}
+	for _, option := range options {
+		option(opts)
+	}
+	return opts
+}
+
+// RandomArchive writes to `w` an archive of format `format`.  By default, it holds random
+// directories and files; see the ArchiveOption functions for given entries and hostile entries.
+// It returns the entries written to the archive in their order.
+func RandomArchive(w io.Writer, format ArchiveFormat, opts ...ArchiveOption) ([]ArchiveEntry, error) {
+	return Default().RandomArchive(w, format, opts...)
+}
+
+// RandomArchive writes to `w` an archive of format `format`.  See RandomArchive.
+func (g *Generator) RandomArchive(w io.Writer, format ArchiveFormat, opts ...ArchiveOption) ([]ArchiveEntry, error) {
+	ao := collectArchiveOptions(opts...)
+	entries, err := g.archiveEntries(ao)
+	if err != nil {
+		return nil, err
+	}
+	var b bytes.Buffer
+	switch format {
+	case Zip:
+		err = writeZip(&b, entries)
+	case Tar:
+		entries, err = writeTar(&b, entries, ao.badChecksums)
+	case TarGz:
+		var tb bytes.Buffer
+		if entries, err = writeTar(&tb, entries, ao.badChecksums); err == nil {
+			zw := gzip.NewWriter(&b)
+			if _, err = zw.Write(tb.Bytes()); err == nil {
+				err = zw.Close()
+			}
+		}
+	default:
+		err = fmt.Errorf("unknown archive format %d", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+	_, err = w.Write(b.Bytes())
+	return entries, err
+}
+
+// RandomArchiveFile writes to the file `name` an archive of format `format`.  See RandomArchive.
+func RandomArchiveFile(name string, format ArchiveFormat, opts ...ArchiveOption) ([]ArchiveEntry, error) {
+	return Default().RandomArchiveFile(name, format, opts...)
+}
+
+// RandomArchiveFile writes to the file `name` an archive.  See RandomArchive.
+func (g *Generator) RandomArchiveFile(name string, format ArchiveFormat, opts ...ArchiveOption) ([]ArchiveEntry, error) {
+	f, err := os.Create(name)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := g.RandomArchive(f, format, opts...)
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	return entries, f.Close()
+}
+
+// archiveEntries returns the entries of the archive, the hostile ones last.
+func (g *Generator) archiveEntries(ao *archiveOptions) ([]ArchiveEntry, error) {
+	entries := append([]ArchiveEntry(nil), ao.entries...)
+	switch {
+	case ao.root != "":
+		var err error
+		if entries, err = treeEntries(ao.root); err != nil {
+			return nil, err
+		}
+	case len(entries) == 0:
+		entries = g.randomEntries()
+	}
+	evil := "evil-" + g.RandomName(8)
+	if ao.traversal {
+		entries = append(entries, ArchiveEntry{Name: "../../" + evil, Mode: 0644, Data: []byte(evil)})
+	}
+	if ao.absolute {
+		entries = append(entries, ArchiveEntry{Name: "/tmp/" + evil, Mode: 0644, Data: []byte(evil)})
+	}
+	if ao.duplicates {
+		for _, e := range entries {
+			if e.Mode.IsRegular() {
+				entries = append(entries, ArchiveEntry{Name: e.Name, Mode: e.Mode, Data: g.RandomSlice(0)})
+				break
+			}
+		}
+	}
+	if ao.symlinks {
+		entries = append(entries,
+			ArchiveEntry{Name: evil + "-rel", Mode: os.ModeSymlink | 0777, Target: "../../../../etc/passwd"},
+			ArchiveEntry{Name: evil + "-abs", Mode: os.ModeSymlink | 0777, Target: "/etc/passwd"})
+	}
+	if ao.badChecksums {
+		for i := range entries {
+			entries[i].BadChecksum = entries[i].Mode.IsRegular()
+		}
+	}
+	if ao.declaredSize > 0 {
+		entries = append(entries, ArchiveEntry{Name: evil + ".bin", Mode: 0644, Data: make([]byte, 1024),
+			DeclaredSize: ao.declaredSize})
+	}
+	return entries, nil
+}
+
+// randomEntries returns a few random directories and files.
+func (g *Generator) randomEntries() []ArchiveEntry {
+	var entries []ArchiveEntry
+	dir := ""
+	used := make(map[string]bool)
+	for i := g.between(2, 8); i > 0; i-- {
+		if g.IntN(3) == 0 {
+			dir = joinSlash(dir, "d"+g.RandomName(g.between(1, 8)))
+			entries = append(entries, ArchiveEntry{Name: dir + "/", Mode: os.ModeDir | 0755})
+		}
+		name := joinSlash(dir, setExtension(g.RandomName(g.between(1, 12)), "txt"))
+		if used[name] {
+			continue
+		}
+		used[name] = true
+		entries = append(entries, ArchiveEntry{
+			Name: name,
+			Mode: 0644,
+			Data: []byte(g.RandomText(WithParagraphs(g.between(1, 3)))),
+		})
+	}
+	return entries
+}
+
+// treeEntries returns the entries of the tree under `root`.
+func treeEntries(root string) ([]ArchiveEntry, error) {
+	m, err := ScanTree(root)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]ArchiveEntry, 0, len(m))
+	for _, te := range m {
+		e := ArchiveEntry{Name: te.Path, Mode: te.Mode, Target: te.Target}
+		switch {
+		case te.Mode.IsDir():
+			e.Name += "/"
+		case te.Mode.IsRegular():
+			if e.Data, err = os.ReadFile(filepath.Join(root, filepath.FromSlash(te.Path))); err != nil {
+				return nil, err
+			}
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+// writeZip writes the zip archive of `entries` to `w`.
+func writeZip(w io.Writer, entries []ArchiveEntry) error {
+	zw := zip.NewWriter(w)
+	for _, e := range entries {
+		fh := &zip.FileHeader{Name: e.Name, Method: zip.Deflate, Modified: time.Unix(0, 0).UTC()}
+		fh.SetMode(e.Mode)
+		data := e.Data
+		if e.Mode&os.ModeSymlink != 0 {
+			data = []byte(e.Target)
+		}
+		if e.Mode.IsDir() {
+			fh.Method = zip.Store
+		}
+		if e.DeclaredSize == 0 && !e.BadChecksum {
+			fw, err := zw.CreateHeader(fh)
+			if err != nil {
+				return err
+			}
+			if _, err = fw.Write(data); err != nil {
+				return err
+			}
+			continue
+		}
+		// the raw entry allows any size and checksum.
+		var compressed bytes.Buffer
+		fw, _ := flate.NewWriter(&compressed, flate.DefaultCompression)
+		_, _ = fw.Write(data)
+		_ = fw.Close()
+		fh.CRC32 = crc32.ChecksumIEEE(data)
+		if e.BadChecksum {
+			fh.CRC32 = ^fh.CRC32
+		}
+		fh.CompressedSize64 = uint64(compressed.Len())
+		fh.UncompressedSize64 = uint64(len(data))
+		if e.DeclaredSize > 0 {
+			fh.UncompressedSize64 = uint64(e.DeclaredSize)
+		}
+		raw, err := zw.CreateRaw(fh)
+		if err != nil {
+			return err
+		}
+		if _, err = raw.Write(compressed.Bytes()); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+// writeTar writes the tar archive of `entries` to `w` and returns the entries written, which are
+// fewer when an entry declares more data than it has.  If `badChecksum` is true, the header
+// checksum of the first entry is wrong.
+func writeTar(w io.Writer, entries []ArchiveEntry, badChecksum bool) ([]ArchiveEntry, error) {
+	const blockSize = 512
+	var b bytes.Buffer
+	tw := tar.NewWriter(&b)
+	truncated := false
+	for i, e := range entries {
+		hdr := &tar.Header{Name: e.Name, Mode: int64(e.Mode.Perm()), ModTime: time.Unix(0, 0), Format: tar.FormatPAX}
+		switch {
+		case e.Mode.IsDir():
+			hdr.Typeflag = tar.TypeDir
+		case e.Mode&os.ModeSymlink != 0:
+			hdr.Typeflag, hdr.Linkname = tar.TypeSymlink, e.Target
+		default:
+			hdr.Typeflag, hdr.Size = tar.TypeReg, int64(len(e.Data))
+			if e.DeclaredSize > 0 {
+				hdr.Size = e.DeclaredSize
+			}
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return nil, err
+		}
+		data, extra := e.Data, []byte(nil)
+		if hdr.Typeflag == tar.TypeReg && hdr.Size < int64(len(data)) {
+			data, extra = data[:hdr.Size], data[hdr.Size:]
+		}
+		if _, err := tw.Write(data); err != nil {
+			return nil, err
+		}
+		if len(extra) != 0 {
+			// the extra data follow the padded entry, padded in turn so that the next headers stay aligned.
+			if err := tw.Flush(); err != nil {
+				return nil, err
+			}
+			b.Write(extra)
+			b.Write(make([]byte, (blockSize-len(extra)%blockSize)%blockSize))
+		}
+		if e.DeclaredSize > int64(len(e.Data)) {
+			// the missing data cannot be written, thus the archive ends here.
+			entries = entries[:i+1]
+			truncated = true
+			break
+		}
+	}
+	// the writer refuses to close an incomplete entry but it already wrote its header and data.
+	if !truncated {
+		if err := tw.Close(); err != nil {
+			return nil, err
+		}
+	}
+	p := b.Bytes()
+	if badChecksum && len(p) >= 512 {
+		// the checksum is the octal field at offset 148 of the 512-byte header.
+		p[148] ^= 0x01
+	}
+	_, err := w.Write(p)
+	return entries, err
+}
@@ -0,0 +1,189 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// readTar returns the names and contents of the tar archive `r`, and the first error.
+func readTar(r io.Reader) (map[string][]byte, []string, error) {
+	contents := make(map[string][]byte)
+	var names []string
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return contents, names, nil
+		}
+		if err != nil {
+			return contents, names, err
+		}
+		names = append(names, hdr.Name)
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return contents, names, err
+		}
+		if hdr.Typeflag == tar.TypeSymlink {
+			data = []byte(hdr.Linkname)
+		}
+		contents[hdr.Name] = data
+	}
+}
+
+func Test_RandomArchive_Zip(t *testing.T) {
+	require, assert := Describe(t)
+
+	w := NewInRAMWriter()
+	entries, err := Gen(t).RandomArchive(w, Zip)
+	require.NoError(err)
+	require.NotEmpty(entries)
+	p := []byte(w.String())
+	zr, err := zip.NewReader(bytes.NewReader(p), int64(len(p)))
+	require.NoError(err)
+	require.Len(zr.File, len(entries))
+	for i, f := range zr.File {
+		assert.Equal(entries[i].Name, f.Name)
+		rc, err := f.Open()
+		require.NoError(err)
+		data, err := io.ReadAll(rc)
+		require.NoError(err)
+		assert.Equal(len(entries[i].Data), len(data))
+		assert.Equal(string(entries[i].Data), string(data))
+	}
+}
+
+func Test_RandomArchive_ZipHostile(t *testing.T) {
+	require, assert := Describe(t)
+
+	var b bytes.Buffer
+	entries, err := Gen(t).RandomArchive(&b, Zip, WithPathTraversal(), WithAbsolutePaths(), WithDuplicates(),
+		WithOutsideSymlinks(), WithBadChecksums(), WithHugeDeclaredSize(1<<40))
+	require.NoError(err)
+	zr, err := zip.NewReader(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		// recent Go versions may refuse the insecure paths.
+		require.ErrorIs(err, zip.ErrInsecurePath)
+	}
+	require.NotNil(zr)
+	names := make(map[string]int)
+	var traversal, absolute, symlink, huge bool
+	checksumErrors := 0
+	for _, f := range zr.File {
+		names[f.Name]++
+		traversal = traversal || strings.HasPrefix(f.Name, "../")
+		absolute = absolute || strings.HasPrefix(f.Name, "/")
+		symlink = symlink || f.Mode()&os.ModeSymlink != 0
+		huge = huge || f.UncompressedSize64 == 1<<40
+		if f.Mode().IsRegular() && f.UncompressedSize64 < 1<<40 {
+			rc, err := f.Open()
+			require.NoError(err)
+			if _, err = io.ReadAll(rc); err == zip.ErrChecksum {
+				checksumErrors++
+			}
+		}
+	}
+	assert.True(traversal && absolute && symlink && huge)
+	duplicated := false
+	for _, n := range names {
+		duplicated = duplicated || n > 1
+	}
+	assert.True(duplicated)
+	assert.NotZero(checksumErrors)
+	assert.Len(zr.File, len(entries))
+}
+
+func Test_RandomArchive_Tar(t *testing.T) {
+	require, assert := Describe(t)
+
+	root := filepath.Join(t.TempDir(), "tree")
+	m, err := Gen(t).RandomTree(root, WithSymlinks(0.5))
+	require.NoError(err)
+	name := filepath.Join(t.TempDir(), "tree.tar.gz")
+	entries, err := RandomArchiveFile(name, TarGz, WithArchiveTree(root))
+	require.NoError(err)
+	assert.Len(entries, len(m))
+	f, err := os.Open(name)
+	require.NoError(err)
+	defer func() { _ = f.Close() }()
+	zr, err := gzip.NewReader(f)
+	require.NoError(err)
+	contents, names, err := readTar(zr)
+	require.NoError(err)
+	assert.Len(names, len(m))
+	for _, e := range entries {
+		if e.Mode.IsRegular() {
+			assert.Equal(e.Data, contents[e.Name])
+		}
+		if e.Mode&os.ModeSymlink != 0 {
+			assert.Equal(e.Target, string(contents[e.Name]))
+		}
+	}
+}
+
+func Test_RandomArchive_TarHostile(t *testing.T) {
+	require, assert := Describe(t)
+
+	g := Gen(t)
+	var b bytes.Buffer
+	_, err := g.RandomArchive(&b, Tar, WithPathTraversal(), WithAbsolutePaths(), WithOutsideSymlinks(),
+		WithArchiveEntries(ArchiveEntry{Name: "a.txt", Mode: 0644, Data: []byte("hello")}))
+	require.NoError(err)
+	contents, names, err := readTar(&b)
+	require.NoError(err)
+	assert.Equal([]byte("hello"), contents["a.txt"])
+	assert.True(strings.HasPrefix(names[1], "../../"))
+	assert.True(strings.HasPrefix(names[2], "/tmp/"))
+	assert.Equal("/etc/passwd", string(contents[names[4]]))
+
+	b.Reset()
+	_, err = g.RandomArchive(&b, Tar, WithBadChecksums())
+	require.NoError(err)
+	_, _, err = readTar(&b)
+	assert.ErrorIs(err, tar.ErrHeader)
+
+	b.Reset()
+	entries, err := g.RandomArchive(&b, Tar, WithHugeDeclaredSize(1<<40))
+	require.NoError(err)
+	assert.Equal(int64(1<<40), entries[len(entries)-1].DeclaredSize)
+	_, _, err = readTar(&b)
+	assert.ErrorIs(err, io.ErrUnexpectedEOF)
+
+	// the entries after an entry declaring more data are not written.
+	b.Reset()
+	entries, err = g.RandomArchive(&b, Tar, WithArchiveEntries(
+		ArchiveEntry{Name: "a.txt", Mode: 0644, Data: []byte("hello"), DeclaredSize: 1000},
+		ArchiveEntry{Name: "b.txt", Mode: 0644, Data: []byte("world")}))
+	require.NoError(err)
+	assert.Len(entries, 1)
+	_, names, err = readTar(&b)
+	assert.ErrorIs(err, io.ErrUnexpectedEOF)
+	assert.Equal([]string{"a.txt"}, names)
+
+	// the header of an entry declaring less data lies, and the extra data stand where the next header should.
+	b.Reset()
+	entries, err = g.RandomArchive(&b, Tar, WithArchiveEntries(
+		ArchiveEntry{Name: "a.txt", Mode: 0644, Data: []byte("hello world"), DeclaredSize: 5},
+		ArchiveEntry{Name: "b.txt", Mode: 0644, Data: []byte("again")}))
+	require.NoError(err)
+	assert.Len(entries, 2)
+	assert.True(bytes.Contains(b.Bytes(), []byte(" world")))
+	contents, _, err = readTar(&b)
+	assert.Error(err)
+	assert.Equal([]byte("hello"), contents["a.txt"])
+
+	_, err = g.RandomArchive(&b, ArchiveFormat(42))
+	assert.Error(err)
+	_, err = RandomArchiveFile(filepath.Join(t.TempDir(), "no", "a.zip"), Zip)
+	assert.Error(err)
+}
@@ -0,0 +1,275 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+)
+
+// ByteStrategy is a kind of mutation of MutateBytes.
+type ByteStrategy int
+
+const (
+	// BitFlip flips one bit.
+	BitFlip ByteStrategy = iota
+	// InterestingValue overwrites 1, 2, 4 or 8 bytes with a boundary value such as 0x7f, 0x8000 or
+	// 0xffffffff, in little or big endian.
+	InterestingValue
+	// InsertChunk inserts random bytes.
+	InsertChunk
+	// DeleteChunk deletes bytes.
+	DeleteChunk
+	// DuplicateChunk copies bytes of the input at another offset.
+	DuplicateChunk
+	// Truncate cuts the end of the input.
+	Truncate
+	// Splice replaces the end of the input with the end of another input given by WithSpliceInputs.
+	Splice
+
+	numByteStrategies
+)
+
+// maxChunk is the maximal number of bytes inserted, deleted or duplicated at once.
+const maxChunk = 32
+
+var (
+	byteStrategyNames = []string{"bit-flip", "interesting-value", "insert", "delete", "duplicate", "truncate",
+		"splice"}
+
+	// defaultByteWeights are the default weights of the strategies.
+	defaultByteWeights = []int{4, 3, 2, 2, 2, 1, 1}
+
+	// interestingValues lists boundary values written by InterestingValue, by size in bytes.
+	interestingValues = map[int][]uint64{
+		1: {0, 1, 0x10, 0x20, 0x40, 0x7f, 0x80, 0xfe, 0xff},
+		2: {0, 0x80, 0xff, 0x100, 0x3e8, 0x400, 0x1000, 0x7fff, 0x8000, 0xfffe, 0xffff},
+		4: {0, 0x7fffffff, 0x80000000, 0xfffffffe, 0xffffffff, 0x10000, 0xffff},
+		8: {0, 0x7fffffffffffffff, 0x8000000000000000, 0xffffffffffffffff, 0x100000000},
+	}
+)
+
+// String returns the name of the strategy.
+func (s ByteStrategy) String() string {
+	if s < 0 || s >= numByteStrategies {
+		return fmt.Sprintf("strategy(%d)", int(s))
+	}
+	return byteStrategyNames[s]
+}
+
+// ByteMutation describes a change made by MutateBytes: at Offset, Deleted bytes are replaced by the
+// bytes Inserted.
+type ByteMutation struct {
+	Strategy ByteStrategy
+	// Offset is the offset of the change in the input of the mutation.
+	Offset   int
+	Deleted  int
+	Inserted []byte
+}
+
+// String returns a readable description of the mutation.
+func (m ByteMutation) String() string {
+	return fmt.Sprintf("%s at %d: %d byte(s) replaced by %x", m.Strategy, m.Offset, m.Deleted, m.Inserted)
+}
+
+// ByteMutations is the report of MutateBytes, in the order of the mutations.
+type ByteMutations []ByteMutation
+
+// String returns the description of the mutations, one per line.
+func (ms ByteMutations) String() string {
+	lines := make([]string, len(ms))
+	for i, m := range ms {
+		lines[i] = m.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// ByteMutatorOption allows to parameterize MutateBytes.
+type ByteMutatorOption func(opts *byteMutatorOptions)
+
+type byteMutatorOptions struct {
+	weights   []int
+	mutations int
+	splices   [][]byte
+}
+
+// WithStrategyWeight sets the weight of the strategy `s`.  The probability of a strategy is its
+// weight divided by the sum of the weights.  A weight of 0 disables it.  The default weights are 4
+// for BitFlip, 3 for InterestingValue, 2 for InsertChunk, DeleteChunk and DuplicateChunk, and 1 for
+// Truncate and Splice.
+func WithStrategyWeight(s ByteStrategy, weight int) ByteMutatorOption {
+	return func(bo *byteMutatorOptions) {
+		if s >= 0 && s < numByteStrategies {
+			bo.weights[s] = weight
+		}
+	}
+}
+
+// WithByteMutations sets the number of stacked mutations.  The default is 1.
+func WithByteMutations(n int) ByteMutatorOption {
+	return func(bo *byteMutatorOptions) {
+		bo.mutations = n
+	}
+}
+
+// WithSpliceInputs sets the inputs with which Splice combines the input.  Without them, Splice is
+// disabled.
+func WithSpliceInputs(inputs ...[]byte) ByteMutatorOption {
+	return func(bo *byteMutatorOptions) {
+		bo.splices = inputs
+	}
+}
+
+func collectByteMutatorOptions(options ...ByteMutatorOption) *byteMutatorOptions {
+	opts := &byteMutatorOptions{weights: append([]int(nil), defaultByteWeights...), mutations: 1}
+	for _, option := range options {
+		option(opts)
+	}
+	return opts
+}
+
+// MutateBytes returns a mutated copy of `input`, for instance a golden fixture or the output of
+// RandomSlice, with the report of the mutations.  The strategies are drawn according to their
+// weights.  With the same generator seed, the mutations are the same.  `input` is not modified.
+func MutateBytes(input []byte, opts ...ByteMutatorOption) ([]byte, ByteMutations) {
+	return Default().MutateBytes(input, opts...)
+}
+
+// MutateBytes returns a mutated copy of `input`.  See MutateBytes.
+func (g *Generator) MutateBytes(input []byte, opts ...ByteMutatorOption) ([]byte, ByteMutations) {
+	bo := collectByteMutatorOptions(opts...)
+	p := append([]byte(nil), input...)
+	var report ByteMutations
+	for i := 0; i < bo.mutations; i++ {
+		s, ok := g.byteStrategy(bo, len(p))
+		if !ok {
+			break
+		}
+		m := g.byteMutation(s, p, bo.splices)
+		p = m.apply(p)
+		report = append(report, m)
+	}
+	return p, report
+}
+
+// ArbMutatedBytes returns an Arbitrary of []byte mutating one of the `seeds`, for instance golden
+// fixtures, with MutateBytes.  The number of mutations grows with the size, unless `opts` sets it.
+// It shrinks by removing bytes.
+func ArbMutatedBytes(seeds [][]byte, opts ...ByteMutatorOption) Arbitrary {
+	const sizePerMutation = 20
+	return Arbitrary{
+		Generate: func(g *Generator, size int) interface{} {
+			var seed []byte
+			if len(seeds) != 0 {
+				seed = seeds[g.IntN(len(seeds))]
+			}
+			all := append([]ByteMutatorOption{WithByteMutations(1 + size/sizePerMutation)}, opts...)
+			p, _ := g.MutateBytes(seed, all...)
+			return p
+		},
+		Shrink: ArbBytes().Shrink,
+	}
+}
+
+// byteStrategy draws a strategy applicable to an input of `n` bytes.
+func (g *Generator) byteStrategy(bo *byteMutatorOptions, n int) (ByteStrategy, bool) {
+	weights := append([]int(nil), bo.weights...)
+	if n == 0 {
+		for _, s := range []ByteStrategy{BitFlip, InterestingValue, DeleteChunk, DuplicateChunk, Truncate} {
+			weights[s] = 0
+		}
+	}
+	if len(bo.splices) == 0 {
+		weights[Splice] = 0
+	}
+	total := 0
+	for _, w := range weights {
+		if w > 0 {
+			total += w
+		}
+	}
+	if total == 0 {
+		return 0, false
+	}
+	x := g.IntN(total)
+	for s, w := range weights {
+		if w <= 0 {
+			continue
+		}
+		if x < w {
+			return ByteStrategy(s), true
+		}
+		x -= w
+	}
+	return 0, false
+}
+
+// byteMutation returns a mutation of `p` with the strategy `s`.
+func (g *Generator) byteMutation(s ByteStrategy, p []byte, splices [][]byte) ByteMutation {
+	m := ByteMutation{Strategy: s}
+	chunk := func(limit int) int {
+		if limit > maxChunk {
+			limit = maxChunk
+		}
+		return g.between(1, limit)
+	}
+	switch s {
+	case BitFlip:
+		m.Offset, m.Deleted = g.IntN(len(p)), 1
+		m.Inserted = []byte{p[m.Offset] ^ 1<<uint(g.IntN(8))}
+	case InterestingValue:
+		size := 1
+		for _, sz := range []int{2, 4, 8} {
+			if sz <= len(p) && g.IntN(2) == 0 {
+				size = sz
+			}
+		}
+		m.Offset, m.Deleted = g.IntN(len(p)-size+1), size
+		m.Inserted = interestingBytes(g, size)
+	case InsertChunk:
+		m.Offset = g.IntN(len(p) + 1)
+		m.Inserted = make([]byte, chunk(maxChunk))
+		_, _ = g.Read(m.Inserted)
+	case DeleteChunk:
+		m.Deleted = chunk(len(p))
+		m.Offset = g.IntN(len(p) - m.Deleted + 1)
+	case DuplicateChunk:
+		n := chunk(len(p))
+		from := g.IntN(len(p) - n + 1)
+		m.Offset = g.IntN(len(p) + 1)
+		m.Inserted = append([]byte(nil), p[from:from+n]...)
+	case Truncate:
+		m.Offset = g.IntN(len(p))
+		m.Deleted = len(p) - m.Offset
+	case Splice:
+		other := splices[g.IntN(len(splices))]
+		m.Offset = g.IntN(len(p) + 1)
+		m.Deleted = len(p) - m.Offset
+		m.Inserted = append([]byte(nil), other[g.IntN(len(other)+1):]...)
+	}
+	return m
+}
+
+// interestingBytes returns an interesting value of `size` bytes in random endianness.
+func interestingBytes(g *Generator, size int) []byte {
+	values := interestingValues[size]
+	v := values[g.IntN(len(values))]
+	var b [8]byte
+	if g.IntN(2) == 0 {
+		binary.LittleEndian.PutUint64(b[:], v)
+		return b[:size]
+	}
+	binary.BigEndian.PutUint64(b[:], v)
+	return b[8-size:]
+}
+
+// apply returns a copy of `p` with the mutation.
+func (m ByteMutation) apply(p []byte) []byte {
+	out := make([]byte, 0, len(p)-m.Deleted+len(m.Inserted))
+	out = append(out, p[:m.Offset]...)
+	out = append(out, m.Inserted...)
+	return append(out, p[m.Offset+m.Deleted:]...)
+}
@@ -0,0 +1,105 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"bytes"
+	"testing"
+)
+
+func Test_MutateBytes(t *testing.T) {
+	require, assert := Describe(t)
+
+	g := Gen(t)
+	input := g.RandomSlice(64)
+	golden := append([]byte(nil), input...)
+	counts := map[ByteStrategy]int{}
+	for i := 0; i < loops*4; i++ {
+		out, ms := g.MutateBytes(input, WithByteMutations(3), WithSpliceInputs([]byte("other input")))
+		require.Len(ms, 3)
+		// replaying the report gives the output.
+		p := input
+		for _, m := range ms {
+			counts[m.Strategy]++
+			require.True(m.Offset >= 0 && m.Offset+m.Deleted <= len(p), m.String())
+			p = m.apply(p)
+		}
+		assert.Equal(out, p, ms.String())
+	}
+	assert.Equal(golden, input)
+	assert.Len(counts, int(numByteStrategies))
+	assert.Greater(counts[BitFlip], counts[Truncate])
+
+	// the same seed gives the same mutations.
+	out1, ms1 := NewGenerator(7).MutateBytes(input, WithByteMutations(5))
+	out2, ms2 := NewGenerator(7).MutateBytes(input, WithByteMutations(5))
+	assert.Equal(out1, out2)
+	assert.Equal(ms1.String(), ms2.String())
+}
+
+func Test_MutateBytes_Strategies(t *testing.T) {
+	_, assert := Describe(t)
+
+	g := Gen(t)
+	only := func(s ByteStrategy) []ByteMutatorOption {
+		opts := []ByteMutatorOption{WithSpliceInputs([]byte("XYZ"))}
+		for i := ByteStrategy(0); i < numByteStrategies; i++ {
+			if i != s {
+				opts = append(opts, WithStrategyWeight(i, 0))
+			}
+		}
+		return opts
+	}
+	input := bytes.Repeat([]byte{0x55}, 40)
+	for i := 0; i < loops; i++ {
+		out, ms := g.MutateBytes(input, only(BitFlip)...)
+		assert.Len(out, len(input))
+		assert.Equal(1, countDiffBits(input, out), ms.String())
+
+		out, _ = g.MutateBytes(input, only(Truncate)...)
+		assert.Less(len(out), len(input))
+		assert.True(bytes.HasPrefix(input, out))
+
+		out, ms = g.MutateBytes(input, only(InterestingValue)...)
+		assert.Len(out, len(input))
+		assert.Contains([]int{1, 2, 4, 8}, len(ms[0].Inserted))
+
+		out, _ = g.MutateBytes(input, only(DeleteChunk)...)
+		assert.Less(len(out), len(input))
+
+		out, _ = g.MutateBytes(input, only(DuplicateChunk)...)
+		assert.Equal(bytes.Repeat([]byte{0x55}, len(out)), out)
+
+		out, ms = g.MutateBytes(input, only(Splice)...)
+		assert.Equal(ms[0].Offset+len(ms[0].Inserted), len(out))
+	}
+	// only the insertion applies to an empty input.
+	out, ms := g.MutateBytes(nil, WithStrategyWeight(Splice, 0))
+	assert.NotEmpty(out)
+	assert.Equal(InsertChunk, ms[0].Strategy)
+	out, ms = g.MutateBytes(nil, WithStrategyWeight(InsertChunk, 0))
+	assert.Empty(out)
+	assert.Empty(ms)
+	assert.Equal("strategy(42)", ByteStrategy(42).String())
+}
+
+func Test_ArbMutatedBytes(t *testing.T) {
+	golden := []byte("GIF89a golden fixture")
+	Check(t, func(p []byte) bool {
+		// a decoder would be called here; the mutated inputs stay close to the fixture.
+		return len(p) <= len(golden)+maxChunk*6
+	}, ArbMutatedBytes([][]byte{golden}, WithStrategyWeight(Splice, 0)))
+}
+
+// countDiffBits returns the number of different bits between `a` and `b` of the same length.
+func countDiffBits(a []byte, b []byte) int {
+	n := 0
+	for i := range a {
+		for x := a[i] ^ b[i]; x != 0; x &= x - 1 {
+			n++
+		}
+	}
+	return n
+}
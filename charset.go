@@ -0,0 +1,184 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Charset is an alphabet from which random strings are drawn.  Each character may have a weight.
+// A Charset is immutable, thus safe for concurrent use.
+type Charset struct {
+	chars []rune
+	cumul []int // cumulative weights.  nil when all characters have the same weight.
+	ascii bool
+}
+
+var (
+	// charsets holds the character set of every AlphaNumType, built-in or registered.
+	charsets = map[AlphaNumType]*Charset{
+		All:             NewCharset("ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz01234567890 @.!$&_+-:;*?#/\\,()[]{}<>%\""),
+		AllCVS:          NewCharset("ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz01234567890 @.!$&_+-:*?#/\\()[]{}<>%\""),
+		AlphaNum:        NewCharset("ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz01234567890 "),
+		AlphaNumNoSpace: NewCharset("ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz01234567890"),
+		Alpha:           NewCharset("ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz "),
+		AlphaNoSpace:    NewCharset("ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"),
+		Caps:            NewCharset("ABCDEFGHIJKLMNOPQRSTUVWXYZ"),
+		Small:           NewCharset("abcdefghijklmnopqrstuvwxyz"),
+		Hex:             NewCharset("0123456789abcdef"),
+		LDH:             NewCharset("ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-"),
+		AText:           NewCharset("ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789!#$%&'*+-/=?^_`{|}~"),
+		Unreserved:      NewCharset("ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-._~"),
+		Digits:          NewCharset("0123456789"),
+		Base32:          NewCharset("ABCDEFGHIJKLMNOPQRSTUVWXYZ234567"),
+		Base64:          NewCharset("ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/"),
+	}
+	charsetsMu sync.RWMutex
+	// nextType is the value of the next registered AlphaNumType.
+	nextType = endOfBuiltins
+)
+
+// NewCharset creates a Charset with the characters of `chars`.  A character present several
+// times is proportionally more frequent.
+func NewCharset(chars string) *Charset {
+	return &Charset{chars: []rune(chars), ascii: isASCII(chars)}
+}
+
+// NewWeightedCharset creates a Charset where each character of `weights` is drawn with a
+// probability proportional to its weight.  The characters with a null or negative weight are ignored.
+func NewWeightedCharset(weights map[rune]int) *Charset {
+	chars := make([]rune, 0, len(weights))
+	for r, w := range weights {
+		if w > 0 {
+			chars = append(chars, r)
+		}
+	}
+	// sorts for the drawing to be reproducible.
+	sort.Slice(chars, func(i, j int) bool { return chars[i] < chars[j] })
+	c := &Charset{chars: chars, cumul: make([]int, len(chars)), ascii: isASCII(string(chars))}
+	total := 0
+	for i, r := range chars {
+		total += weights[r]
+		c.cumul[i] = total
+	}
+	return c
+}
+
+// CharsetOf returns the Charset of the type `t`.  It returns false if `t` is not a built-in nor a
+// registered type, or if it is a Unicode type such as Emoji.
+func CharsetOf(t AlphaNumType) (*Charset, bool) {
+	charsetsMu.RLock()
+	defer charsetsMu.RUnlock()
+	c, ok := charsets[t]
+	return c, ok
+}
+
+// RegisterAlphaNumType registers the Charset `c` and returns its new AlphaNumType.  The returned
+// type can be used with RandomAlphaString like the built-in ones.  It panics if `c` is nil.
+func RegisterAlphaNumType(c *Charset) AlphaNumType {
+	if c == nil {
+		panic("test: RegisterAlphaNumType called with a nil Charset")
+	}
+	charsetsMu.Lock()
+	defer charsetsMu.Unlock()
+	t := nextType
+	nextType++
+	charsets[t] = c
+	return t
+}
+
+// Without returns a copy of the Charset without the characters of `excluded`.  The weights of the
+// remaining characters are preserved.
+func (c *Charset) Without(excluded string) *Charset {
+	nc := &Charset{}
+	prev := 0
+	for i, r := range c.chars {
+		w := 1
+		if c.cumul != nil {
+			w = c.cumul[i] - prev
+			prev = c.cumul[i]
+		}
+		if strings.ContainsRune(excluded, r) {
+			continue
+		}
+		nc.chars = append(nc.chars, r)
+		if c.cumul != nil {
+			nc.cumul = append(nc.cumul, w+last(nc.cumul))
+		}
+	}
+	nc.ascii = isASCII(string(nc.chars))
+	return nc
+}
+
+// Len returns the number of characters of the Charset.
+func (c *Charset) Len() int {
+	return len(c.chars)
+}
+
+// String returns the characters of the Charset.
+func (c *Charset) String() string {
+	return string(c.chars)
+}
+
+// RandomCharsetString generates a size-character random string drawn from the Charset `c`.
+// If size is zero or negative, then the length of the string is random in the range
+// 1 to 256 characters.  If the Charset is empty, the returned value is the empty string.
+func RandomCharsetString(size int, c *Charset) string {
+	return Default().RandomCharsetString(size, c)
+}
+
+// RandomCharsetString generates a size-character random string drawn from the Charset `c`.
+// See RandomCharsetString.
+func (g *Generator) RandomCharsetString(size int, c *Charset) string {
+	const size0 = 256 // max number of characters for random set.
+	if size <= 0 {
+		size = g.IntN(size0) + 1
+	}
+	if len(c.chars) == 0 {
+		return ""
+	}
+	if c.ascii {
+		buffer := make([]byte, size)
+		for i := range buffer {
+			buffer[i] = byte(c.pick(g))
+		}
+		return string(buffer)
+	}
+	var sb strings.Builder
+	for i := 0; i < size; i++ {
+		sb.WriteRune(c.pick(g))
+	}
+	return sb.String()
+}
+
+// pick draws a character of the Charset.
+func (c *Charset) pick(g *Generator) rune {
+	if c.cumul == nil {
+		return c.chars[g.IntN(len(c.chars))]
+	}
+	n := g.IntN(last(c.cumul))
+	return c.chars[sort.SearchInts(c.cumul, n+1)]
+}
+
+// isASCII returns true if `s` holds only ASCII characters.
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// last returns the last element of `list`, or zero if empty.
+func last(list []int) int {
+	if len(list) == 0 {
+		return 0
+	}
+	return list[len(list)-1]
+}
@@ -0,0 +1,67 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func Test_RegisterAlphaNumType(t *testing.T) {
+	_, assert := Describe(t)
+
+	g := Gen(t)
+	hex := RegisterAlphaNumType(NewCharset("0123456789ABCDEF"))
+	assert.GreaterOrEqual(hex, endOfBuiltins)
+	s := g.RandomAlphaString(100, hex)
+	assert.Len(s, 100)
+	assert.Empty(strings.Trim(s, "0123456789ABCDEF"))
+
+	greek := RegisterAlphaNumType(NewCharset("αβγδε"))
+	assert.NotEqual(hex, greek)
+	s = g.RandomAlphaString(10, greek)
+	assert.Equal(10, utf8.RuneCountInString(s))
+	assert.Len(g.RandomAlphaStringSized(11, Bytes, greek), 11)
+
+	c, ok := CharsetOf(greek)
+	assert.True(ok)
+	assert.Equal("αβγδε", c.String())
+	_, ok = CharsetOf(Emoji)
+	assert.False(ok)
+	assert.Panics(func() { RegisterAlphaNumType(nil) })
+}
+
+func Test_Charset_Without(t *testing.T) {
+	_, assert := Describe(t)
+
+	g := Gen(t)
+	c, _ := CharsetOf(AlphaNumNoSpace)
+	c = c.Without("0O1lI")
+	s := g.RandomCharsetString(500, c)
+	assert.False(strings.ContainsAny(s, "0O1lI"))
+	assert.Equal("", g.RandomCharsetString(10, c.Without(c.String())))
+
+	w := NewWeightedCharset(map[rune]int{'a': 1, 'b': 0, 'c': 1000}).Without("a")
+	assert.Equal(1, w.Len())
+	assert.Equal("ccc", g.RandomCharsetString(3, w))
+}
+
+func Test_NewWeightedCharset(t *testing.T) {
+	_, assert := Describe(t)
+
+	c := NewWeightedCharset(map[rune]int{'a': 1, 'b': 99, 'z': -1})
+	assert.Equal("ab", c.String())
+	s := Gen(t).RandomCharsetString(1000, c)
+	assert.Greater(strings.Count(s, "b"), 900)
+	assert.NotContains(s, "z")
+	assert.Equal(NewGenerator(1).RandomCharsetString(50, c), NewGenerator(1).RandomCharsetString(50, c))
+}
+
+func Benchmark_RandomAlphaString(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		RandomAlphaString(64, AlphaNum)
+	}
+}
@@ -0,0 +1,536 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// maxShrinks is the maximal number of successful shrinking steps of a counterexample.
+const maxShrinks = 1000
+
+// Arbitrary generates and shrinks the random values of an argument of a property checked by Check.
+type Arbitrary struct {
+	// Generate returns a random value.  `size` grows along the iterations from 0 to the maximal size
+	// of the check.  It bounds the length or the magnitude of the value.
+	Generate func(g *Generator, size int) interface{}
+	// Shrink returns simpler values than `v`, the simplest first.  It may be nil.
+	Shrink func(v interface{}) []interface{}
+}
+
+// CheckOption allows to parameterize a Checker.
+type CheckOption func(opts *checkOptions)
+
+type checkOptions struct {
+	iterations int
+	maxSize    int
+	budget     time.Duration
+}
+
+// WithIterations sets the number of random inputs tried by the check.  The default is 100.
+func WithIterations(n int) CheckOption {
+	return func(co *checkOptions) {
+		co.iterations = n
+	}
+}
+
+// WithMaxSize sets the size reached by the last iteration.  The size grows linearly from 0.  The
+// default is 100.
+func WithMaxSize(n int) CheckOption {
+	return func(co *checkOptions) {
+		co.maxSize = n
+	}
+}
+
+// WithTimeBudget stops the check once `d` elapsed, even if all the iterations did not run.  The
+// shrinking of a counterexample has the same budget.  The default is no limit.
+func WithTimeBudget(d time.Duration) CheckOption {
+	return func(co *checkOptions) {
+		co.budget = d
+	}
+}
+
+func collectCheckOptions(options ...CheckOption) *checkOptions {
+	opts := &checkOptions{iterations: 100, maxSize: 100}
+	for _, option := range options {
+		option(opts)
+	}
+	return opts
+}
+
+// Checker runs the property-based tests.  See Check.
+type Checker struct {
+	opts *checkOptions
+}
+
+// NewChecker returns a Checker configured by `opts`.
+func NewChecker(opts ...CheckOption) *Checker {
+	return &Checker{opts: collectCheckOptions(opts...)}
+}
+
+// Check verifies that `property` holds for random inputs with the default configuration.
+// `property` is a function with one argument per Arbitrary of `arbs`.  It returns either a bool,
+// false meaning failure, or an error, non-nil meaning failure.  A panic is a failure.
+//
+// On failure, Check shrinks the input to a minimal counterexample and reports it through an
+// assert, as returned by Describe, with the seed to replay the test.  It returns true if the
+// property held for all the inputs.  The inputs come from the generator of the test (see Gen).
+//
+// For instance,
+//
+//	Check(t, func(a, b int) bool { return a+b == b+a }, ArbInt(-100, 100), ArbInt(-100, 100))
+//
+// NewChecker configures the number of iterations, the size growth and the time budget.
+func Check(t testing.TB, property interface{}, arbs ...Arbitrary) bool {
+	return NewChecker().Check(t, property, arbs...)
+}
+
+// Check verifies that `property` holds for random inputs.  See Check.
+func (c *Checker) Check(t testing.TB, property interface{}, arbs ...Arbitrary) bool {
+	p, err := newProperty(property, len(arbs))
+	if err != nil {
+		return assert.New(t).Fail(err.Error())
+	}
+	g := Gen(t)
+	start := time.Now()
+	for i := 0; i < c.opts.iterations; i++ {
+		if c.opts.budget > 0 && time.Since(start) > c.opts.budget {
+			break
+		}
+		size := c.opts.maxSize
+		if c.opts.iterations > 1 {
+			size = c.opts.maxSize * i / (c.opts.iterations - 1)
+		}
+		args, err := generate(g, arbs, size)
+		if err != nil {
+			return assert.New(t).Fail(err.Error(), replayMessage(t.Name()))
+		}
+		failure := p.run(args)
+		if failure == nil {
+			continue
+		}
+		args, steps, failure := shrink(p, arbs, args, failure, c.opts.budget)
+		return assert.New(t).Fail(fmt.Sprintf("property failed after %d tests and %d shrinks: %v", i+1, steps, failure),
+			fmt.Sprintf("counterexample: %s\n%s", formatArgs(args), replayMessage(t.Name())))
+	}
+	return true
+}
+
+// generate returns the values of `arbs` of size `size`.  It returns an error if an Arbitrary panics.
+func generate(g *Generator, arbs []Arbitrary, size int) (args []interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("generation failed: %v", r)
+		}
+	}()
+	args = make([]interface{}, len(arbs))
+	for i, a := range arbs {
+		args[i] = a.Generate(g, size)
+	}
+	return args, nil
+}
+
+// property is a function checked by Check.
+type property struct {
+	fn reflect.Value
+}
+
+// newProperty returns the property `fn` after checking that it has `n` arguments and returns a
+// bool or an error.
+func newProperty(fn interface{}, n int) (*property, error) {
+	v := reflect.ValueOf(fn)
+	if v.Kind() != reflect.Func {
+		return nil, errors.New("the property is not a function")
+	}
+	t := v.Type()
+	if t.NumIn() != n {
+		return nil, fmt.Errorf("the property has %d arguments but there are %d arbitraries", t.NumIn(), n)
+	}
+	errorType := reflect.TypeOf((*error)(nil)).Elem()
+	if t.NumOut() != 1 || (t.Out(0).Kind() != reflect.Bool && t.Out(0) != errorType) {
+		return nil, errors.New("the property must return a bool or an error")
+	}
+	return &property{fn: v}, nil
+}
+
+// run calls the property with `args`.  It returns nil if the property holds, else the reason of
+// the failure.
+func (p *property) run(args []interface{}) (failure error) {
+	in := make([]reflect.Value, len(args))
+	for i, a := range args {
+		t := p.fn.Type().In(i)
+		v := reflect.ValueOf(a)
+		switch {
+		case a == nil:
+			v = reflect.Zero(t)
+		case v.Type().AssignableTo(t):
+		case v.Type().ConvertibleTo(t):
+			v = v.Convert(t)
+		default:
+			return fmt.Errorf("argument %d: %s is not assignable to %s", i+1, v.Type(), t)
+		}
+		in[i] = v
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			failure = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	out := p.fn.Call(in)[0]
+	if out.Kind() == reflect.Bool {
+		if !out.Bool() {
+			return errors.New("returned false")
+		}
+		return nil
+	}
+	if !out.IsNil() {
+		return out.Interface().(error)
+	}
+	return nil
+}
+
+// shrink reduces the failing arguments `args` until none of their shrunk values fails.  It
+// returns the minimal arguments, the number of successful shrinking steps and their failure.
+func shrink(p *property, arbs []Arbitrary, args []interface{}, failure error,
+	budget time.Duration) ([]interface{}, int, error) {
+	start, steps := time.Now(), 0
+	for progress := true; progress && steps < maxShrinks; {
+		progress = false
+		for i := 0; i < len(args) && !progress; i++ {
+			if arbs[i].Shrink == nil {
+				continue
+			}
+			for _, c := range arbs[i].Shrink(args[i]) {
+				if budget > 0 && time.Since(start) > budget {
+					return args, steps, failure
+				}
+				candidate := append([]interface{}(nil), args...)
+				candidate[i] = c
+				if f := p.run(candidate); f != nil {
+					args, failure, progress = candidate, f, true
+					steps++
+					break
+				}
+			}
+		}
+	}
+	return args, steps, failure
+}
+
+// formatArgs returns a readable representation of the arguments `args`.
+func formatArgs(args []interface{}) string {
+	s := make([]string, len(args))
+	for i, a := range args {
+		s[i] = fmt.Sprintf("%#v", a)
+	}
+	return "(" + strings.Join(s, ", ") + ")"
+}
+
+// ArbInt returns an Arbitrary of int in the range `minimum` to `maximum`.  The values stay within
+// `size` of the value closest to 0, except a tenth of them that span the whole range with edge
+// values.  It shrinks toward the value closest to 0.
+func ArbInt(minimum int, maximum int) Arbitrary {
+	target := 0
+	if target < minimum {
+		target = minimum
+	}
+	if target > maximum {
+		target = maximum
+	}
+	return Arbitrary{
+		Generate: func(g *Generator, size int) interface{} {
+			if g.IntN(10) == 0 {
+				return g.RandomInt(minimum, maximum, WithEdgeValues(0.5))
+			}
+			lo, hi := target-size, target+size
+			if lo < minimum || lo > target {
+				lo = minimum
+			}
+			if hi > maximum || hi < target {
+				hi = maximum
+			}
+			return g.RandomInt(lo, hi)
+		},
+		Shrink: func(v interface{}) []interface{} {
+			n := v.(int)
+			var candidates []interface{}
+			for d := (n - target) / 2; d != 0; d /= 2 {
+				candidates = append(candidates, n-d)
+			}
+			if n != target {
+				candidates = append([]interface{}{target}, candidates...)
+				if n > target {
+					candidates = append(candidates, n-1)
+				} else {
+					candidates = append(candidates, n+1)
+				}
+			}
+			return candidates
+		},
+	}
+}
+
+// ArbFloat64 returns an Arbitrary of float64 in the range `minimum` to `maximum`.  The values stay
+// within `size` of the value closest to 0, except a tenth of them that span the whole range with
+// edge values.  It shrinks toward the value closest to 0 and toward integers.
+func ArbFloat64(minimum float64, maximum float64) Arbitrary {
+	target := 0.0
+	if target < minimum {
+		target = minimum
+	}
+	if target > maximum {
+		target = maximum
+	}
+	return Arbitrary{
+		Generate: func(g *Generator, size int) interface{} {
+			if g.IntN(10) == 0 {
+				return g.RandomFloat64(minimum, maximum, WithEdgeValues(0.5))
+			}
+			lo, hi := target-float64(size), target+float64(size)
+			if lo < minimum {
+				lo = minimum
+			}
+			if hi > maximum {
+				hi = maximum
+			}
+			return g.RandomFloat64(lo, hi)
+		},
+		Shrink: func(v interface{}) []interface{} {
+			x := v.(float64)
+			if x == target || math.IsNaN(x) {
+				return nil
+			}
+			candidates := []interface{}{target}
+			if t := float64(int64(x)); t != x && t >= minimum && t <= maximum {
+				candidates = append(candidates, t)
+			}
+			// the halving of the distance stops at a millionth of it.
+			for d, i := (x-target)/2, 0; i < 20 && x-d != x; d, i = d/2, i+1 {
+				candidates = append(candidates, x-d)
+			}
+			return candidates
+		},
+	}
+}
+
+// ArbBool returns an Arbitrary of bool.  It shrinks toward false.
+func ArbBool() Arbitrary {
+	return Arbitrary{
+		Generate: func(g *Generator, _ int) interface{} { return g.IntN(2) == 0 },
+		Shrink: func(v interface{}) []interface{} {
+			if v.(bool) {
+				return []interface{}{false}
+			}
+			return nil
+		},
+	}
+}
+
+// ArbString returns an Arbitrary of string of the character set `t` with at most `size` runes.
+// It shrinks by removing runes.
+func ArbString(t AlphaNumType) Arbitrary {
+	return Arbitrary{
+		Generate: func(g *Generator, size int) interface{} {
+			if size <= 0 {
+				return ""
+			}
+			return g.RandomAlphaString(g.IntN(size)+1, t)
+		},
+		Shrink: func(v interface{}) []interface{} {
+			s := v.(string)
+			if !utf8.ValidString(s) {
+				return shrinkSequence(reflect.ValueOf([]byte(s)), func(r reflect.Value) interface{} {
+					return string(r.Bytes())
+				})
+			}
+			return shrinkSequence(reflect.ValueOf([]rune(s)), func(r reflect.Value) interface{} {
+				return string(r.Interface().([]rune))
+			})
+		},
+	}
+}
+
+// ArbBytes returns an Arbitrary of []byte with at most `size` random bytes.  It shrinks by
+// removing bytes.
+func ArbBytes() Arbitrary {
+	return Arbitrary{
+		Generate: func(g *Generator, size int) interface{} {
+			p := make([]byte, g.IntN(size+1))
+			_, _ = g.Read(p)
+			return p
+		},
+		Shrink: func(v interface{}) []interface{} {
+			return shrinkSequence(reflect.ValueOf(v), reflect.Value.Interface)
+		},
+	}
+}
+
+// ArbOneOf returns an Arbitrary picking one of `values`.  It shrinks toward the first values.
+// Without values, it generates nil.
+func ArbOneOf(values ...interface{}) Arbitrary {
+	return Arbitrary{
+		Generate: func(g *Generator, _ int) interface{} {
+			if len(values) == 0 {
+				return nil
+			}
+			return values[g.IntN(len(values))]
+		},
+		Shrink: func(v interface{}) []interface{} {
+			for i, x := range values {
+				if reflect.DeepEqual(x, v) {
+					return values[:i]
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// ArbFunc returns an Arbitrary generated by `fn`, for instance a generator of this package such as
+// RandomEmail.  It does not shrink.
+func ArbFunc(fn func(g *Generator) interface{}) Arbitrary {
+	return Arbitrary{
+		Generate: func(g *Generator, _ int) interface{} { return fn(g) },
+	}
+}
+
+// ArbOf returns an Arbitrary of the type of `sample`, such as a struct, generated by Fill with the
+// options `opts`.  `sample` only provides the type.  It shrinks the numbers toward 0, the strings,
+// slices and maps by removing elements, the pointers toward nil and the structs field by field.
+// The generation panics with the error of Fill, such as ErrBadTag, which fails Check.
+func ArbOf(sample interface{}, opts ...FillOption) Arbitrary {
+	t := reflect.TypeOf(sample)
+	return Arbitrary{
+		Generate: func(g *Generator, _ int) interface{} {
+			p := reflect.New(t)
+			if err := g.Fill(p.Interface(), opts...); err != nil {
+				panic(err)
+			}
+			return p.Elem().Interface()
+		},
+		Shrink: func(v interface{}) []interface{} {
+			var candidates []interface{}
+			for _, c := range shrinkValue(reflect.ValueOf(v)) {
+				candidates = append(candidates, c.Interface())
+			}
+			return candidates
+		},
+	}
+}
+
+// shrinkSequence returns the shrunk candidates of the slice `v`: the empty slice, then `v` without
+// chunks of decreasing size.  `conv` converts the candidates.
+func shrinkSequence(v reflect.Value, conv func(reflect.Value) interface{}) []interface{} {
+	var candidates []interface{}
+	for _, c := range removeChunks(v) {
+		candidates = append(candidates, conv(c))
+	}
+	return candidates
+}
+
+// removeChunks returns the slice `v` without chunks of n/2, n/4, ..., 1 elements.
+func removeChunks(v reflect.Value) []reflect.Value {
+	n := v.Len()
+	if n == 0 {
+		return nil
+	}
+	candidates := []reflect.Value{reflect.MakeSlice(v.Type(), 0, 0)}
+	for chunk := n / 2; chunk > 0; chunk /= 2 {
+		for start := 0; start+chunk <= n; start += chunk {
+			c := reflect.MakeSlice(v.Type(), 0, n-chunk)
+			c = reflect.AppendSlice(c, v.Slice(0, start))
+			c = reflect.AppendSlice(c, v.Slice(start+chunk, n))
+			candidates = append(candidates, c)
+		}
+	}
+	return candidates
+}
+
+// shrinkValue returns simpler values than `v` by reflection.
+func shrinkValue(v reflect.Value) []reflect.Value {
+	var candidates []reflect.Value
+	add := func(x interface{}) {
+		c := reflect.New(v.Type()).Elem()
+		c.Set(reflect.ValueOf(x).Convert(v.Type()))
+		candidates = append(candidates, c)
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			add(false)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		for n := v.Int(); n != 0; n /= 2 {
+			add(v.Int() - n)
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		for n := v.Uint(); n != 0; n /= 2 {
+			add(v.Uint() - n)
+		}
+	case reflect.Float32, reflect.Float64:
+		if x := v.Float(); x != 0 && !math.IsNaN(x) {
+			add(0.0)
+			if t := float64(int64(x)); t != x {
+				add(t)
+			}
+		}
+	case reflect.String:
+		for _, c := range removeChunks(reflect.ValueOf([]rune(v.String()))) {
+			add(string(c.Interface().([]rune)))
+		}
+	case reflect.Slice:
+		candidates = append(candidates, removeChunks(v)...)
+		for i := 0; i < v.Len(); i++ {
+			for _, e := range shrinkValue(v.Index(i)) {
+				c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+				reflect.Copy(c, v)
+				c.Index(i).Set(e)
+				candidates = append(candidates, c)
+			}
+		}
+	case reflect.Map:
+		keys := v.MapKeys()
+		for i := range keys {
+			c := reflect.MakeMap(v.Type())
+			for j, k := range keys {
+				if j != i {
+					c.SetMapIndex(k, v.MapIndex(k))
+				}
+			}
+			candidates = append(candidates, c)
+		}
+	case reflect.Ptr:
+		if !v.IsNil() {
+			candidates = append(candidates, reflect.Zero(v.Type()))
+			for _, e := range shrinkValue(v.Elem()) {
+				p := reflect.New(v.Type().Elem())
+				p.Elem().Set(e)
+				candidates = append(candidates, p)
+			}
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).PkgPath != "" {
+				continue
+			}
+			for _, f := range shrinkValue(v.Field(i)) {
+				c := reflect.New(v.Type()).Elem()
+				c.Set(v)
+				c.Field(i).Set(f)
+				candidates = append(candidates, c)
+			}
+		}
+	}
+	return candidates
+}
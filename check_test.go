@@ -0,0 +1,154 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_Check(t *testing.T) {
+	_, assert := Describe(t)
+
+	assert.True(Check(t, func(a, b int) bool { return a+b == b+a }, ArbInt(-100, 100), ArbInt(-100, 100)))
+	assert.True(Check(t, func(s string) error {
+		if strings.ToUpper(strings.ToLower(s)) != strings.ToUpper(s) {
+			return errors.New("case mismatch")
+		}
+		return nil
+	}, ArbString(Alpha)))
+	assert.True(Check(t, func(p []byte, b bool, x float64) bool { return len(p) <= 100 && x >= -1 && x <= 1 },
+		ArbBytes(), ArbBool(), ArbFloat64(-1, 1)))
+
+	sizes := make(map[int]bool)
+	c := NewChecker(WithIterations(11), WithMaxSize(10))
+	assert.True(c.Check(t, func(s string) bool { sizes[len(s)] = true; return len(s) <= 10 }, ArbString(Caps)))
+	assert.True(sizes[0])
+
+	calls := 0
+	c = NewChecker(WithIterations(1000000), WithTimeBudget(10*time.Millisecond))
+	assert.True(c.Check(t, func(int8) bool { calls++; time.Sleep(time.Millisecond); return true }, ArbInt(-5, 5)))
+	assert.Less(calls, 1000)
+}
+
+func Test_Check_Arbitraries(t *testing.T) {
+	_, assert := Describe(t)
+
+	type point struct {
+		X, Y int `test:"min=-9,max=9"`
+		Tags []string
+	}
+	assert.True(Check(t, func(p point) bool { return p.X >= -9 && p.X <= 9 }, ArbOf(point{})))
+	assert.True(Check(t, func(s string) bool { return strings.Contains(s, "@") },
+		ArbFunc(func(g *Generator) interface{} { return g.RandomEmail(Valid) })))
+	assert.True(Check(t, func(s string) bool { return s == "a" || s == "b" }, ArbOneOf("a", "b")))
+	assert.True(Check(t, func(n int) bool { return n >= 10 && n <= 20 }, ArbInt(10, 20)))
+	assert.Nil(ArbOneOf().Generate(Gen(t), 10))
+}
+
+// fakeTB records the failures of a test instead of failing it.
+type fakeTB struct {
+	testing.TB
+	name     string
+	errors   []string
+	logs     []string
+	cleanups []func()
+}
+
+func (f *fakeTB) Name() string            { return f.name }
+func (f *fakeTB) Helper()                 {}
+func (f *fakeTB) Failed() bool            { return len(f.errors) != 0 }
+func (f *fakeTB) Cleanup(fn func())       { f.cleanups = append(f.cleanups, fn) }
+func (f *fakeTB) Log(args ...interface{}) { f.logs = append(f.logs, fmt.Sprint(args...)) }
+func (f *fakeTB) Errorf(format string, args ...interface{}) {
+	f.errors = append(f.errors, fmt.Sprintf(format, args...))
+}
+
+func Test_Check_failure(t *testing.T) {
+	_, assert := Describe(t)
+
+	ft := &fakeTB{name: t.Name() + "/fake"}
+	assert.False(Check(ft, func(n int) bool { return n < 50 }, ArbInt(0, 1000)))
+	for _, fn := range ft.cleanups {
+		fn()
+	}
+	if assert.Len(ft.errors, 1) {
+		// the counterexample is shrunk to the smallest failing value.
+		assert.Contains(ft.errors[0], "counterexample: (50)")
+		assert.Contains(ft.errors[0], "property failed after")
+		assert.Contains(ft.errors[0], "-randseed=")
+	}
+	assert.Len(ft.logs, 1)
+
+	ft = &fakeTB{name: t.Name() + "/bad_tag"}
+	type badTag struct {
+		N int `test:"min=9,max=1"`
+	}
+	assert.False(Check(ft, func(badTag) bool { return true }, ArbOf(badTag{})))
+	for _, fn := range ft.cleanups {
+		fn()
+	}
+	if assert.Len(ft.errors, 1) {
+		assert.Contains(ft.errors[0], "generation failed")
+		assert.Contains(ft.errors[0], ErrBadTag.Error())
+	}
+	assert.Panics(func() { ArbOf(badTag{}).Generate(Gen(t), 0) })
+}
+
+func Test_newProperty(t *testing.T) {
+	_, assert := Describe(t)
+
+	_, err := newProperty(3, 0)
+	assert.Error(err)
+	_, err = newProperty(func(int) bool { return true }, 2)
+	assert.Error(err)
+	_, err = newProperty(func(int) int { return 0 }, 1)
+	assert.Error(err)
+	p, err := newProperty(func(n int) error { return nil }, 1)
+	assert.NoError(err)
+	assert.NoError(p.run([]interface{}{3}))
+	assert.Error(p.run([]interface{}{"three"}))
+
+	p, _ = newProperty(func(n int) bool { return 10/n > 0 }, 1)
+	failure := p.run([]interface{}{0})
+	assert.Error(failure)
+	assert.Contains(failure.Error(), "panic")
+}
+
+func Test_shrink(t *testing.T) {
+	_, assert := Describe(t)
+
+	g := Gen(t)
+	// the smallest counterexample of n < 37 is 37.
+	p, _ := newProperty(func(n int) bool { return n < 37 }, 1)
+	arbs := []Arbitrary{ArbInt(-1000, 1000)}
+	args, steps, failure := shrink(p, arbs, []interface{}{912}, errors.New("fails"), 0)
+	assert.Equal([]interface{}{37}, args)
+	assert.NotZero(steps)
+	assert.Error(failure)
+
+	// the smallest counterexample of "no slice contains 7" is [7].
+	p, _ = newProperty(func(s []int) bool { return sort.SearchInts(s, 7) == len(s) || s[sort.SearchInts(s, 7)] != 7 }, 1)
+	arbs = []Arbitrary{ArbOf([]int(nil))}
+	s := []int{-5, 3, 7, 12, 40}
+	args, _, _ = shrink(p, arbs, []interface{}{s}, errors.New("fails"), 0)
+	assert.Equal([]interface{}{[]int{7}}, args)
+
+	// a string containing "x" shrinks to "x".
+	p, _ = newProperty(func(s string) bool { return !strings.Contains(s, "x") }, 1)
+	arbs = []Arbitrary{ArbString(Small)}
+	in := g.RandomAlphaString(30, Small) + "x" + g.RandomAlphaString(30, Small)
+	args, _, _ = shrink(p, arbs, []interface{}{in}, errors.New("fails"), 0)
+	assert.Equal([]interface{}{"x"}, args)
+
+	p, _ = newProperty(func(x float64, b bool) bool { return x < 2.5 || !b }, 2)
+	args, _, _ = shrink(p, []Arbitrary{ArbFloat64(0, 100), ArbBool()}, []interface{}{77.7, true}, errors.New("fails"), 0)
+	assert.True(args[0].(float64) >= 2.5 && args[0].(float64) < 3)
+	assert.Equal(true, args[1])
+}
@@ -0,0 +1,190 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"encoding/csv"
+	"io"
+	"os"
+	"strconv"
+	"time"
+	"unicode/utf8"
+)
+
+// ColumnKind is the type of the values of a CSV column.
+type ColumnKind int
+
+const (
+	// TextColumn holds random strings.
+	TextColumn ColumnKind = iota
+	// IntColumn holds integers.
+	IntColumn
+	// FloatColumn holds floating point numbers.
+	FloatColumn
+	// DateColumn holds formatted dates.
+	DateColumn
+	// EnumColumn holds one of a list of values.
+	EnumColumn
+)
+
+// Column describes a column of a CSV schema.  It is easier to create it with IntCol, FloatCol,
+// DateCol, EnumCol or TextCol.
+type Column struct {
+	Name string
+	Kind ColumnKind
+	// Min and Max are the range of an IntColumn, both included.
+	Min, Max int64
+	// MinFloat and MaxFloat are the range of a FloatColumn.  Decimals is the number of decimals;
+	// -1 means the minimal number of digits.
+	MinFloat, MaxFloat float64
+	Decimals           int
+	// From and To are the range of a DateColumn, both included.  Layout is its time format, RFC3339 if
+	// empty.
+	From, To time.Time
+	Layout   string
+	// Values are the values of an EnumColumn.
+	Values []string
+	// Charset and MaxLen define the strings of a TextColumn of 1 to MaxLen characters.
+	Charset AlphaNumType
+	MaxLen  int
+	// Null is the probability that a field is empty.
+	Null float64
+}
+
+// CSVSchema describes a random CSV table.
+type CSVSchema struct {
+	Columns []Column
+	// Header requests a first row with the names of the columns.
+	Header bool
+	// Comma is the field separator.  It is ',' if null.
+	Comma rune
+	// UseCRLF requests \r\n as line terminator.
+	UseCRLF bool
+	// Tricky is the probability that a TextColumn field embeds a separator, a quote or a newline,
+	// thus needs escaping.
+	Tricky float64
+}
+
+// IntCol returns a column `name` of integers in the range `minimum` to `maximum` included.
+func IntCol(name string, minimum int64, maximum int64) Column {
+	return Column{Name: name, Kind: IntColumn, Min: minimum, Max: maximum}
+}
+
+// FloatCol returns a column `name` of floating point numbers in the range `minimum` to `maximum`
+// with `decimals` decimals.
+func FloatCol(name string, minimum float64, maximum float64, decimals int) Column {
+	return Column{Name: name, Kind: FloatColumn, MinFloat: minimum, MaxFloat: maximum, Decimals: decimals}
+}
+
+// DateCol returns a column `name` of dates between `from` and `to` formatted with `layout`.
+func DateCol(name string, from time.Time, to time.Time, layout string) Column {
+	return Column{Name: name, Kind: DateColumn, From: from, To: to, Layout: layout}
+}
+
+// EnumCol returns a column `name` whose fields are one of `values`.
+func EnumCol(name string, values ...string) Column {
+	return Column{Name: name, Kind: EnumColumn, Values: values}
+}
+
+// TextCol returns a column `name` of random strings of the character set `t` with 1 to `maxLen`
+// characters.
+func TextCol(name string, t AlphaNumType, maxLen int) Column {
+	return Column{Name: name, Kind: TextColumn, Charset: t, MaxLen: maxLen}
+}
+
+// Nullable returns a copy of the column whose fields are empty with the probability `p`.
+func (c Column) Nullable(p float64) Column {
+	c.Null = p
+	return c
+}
+
+// RandomCSV writes to `w` a CSV table of `rows` rows following `schema`.  The rows are streamed,
+// thus large tables do not need memory.
+func RandomCSV(w io.Writer, schema CSVSchema, rows int) error {
+	return Default().RandomCSV(w, schema, rows)
+}
+
+// RandomCSV writes to `w` a CSV table of `rows` rows following `schema`.  See RandomCSV.
+func (g *Generator) RandomCSV(w io.Writer, schema CSVSchema, rows int) error {
+	wr := csv.NewWriter(w)
+	if schema.Comma != 0 {
+		wr.Comma = schema.Comma
+	}
+	wr.UseCRLF = schema.UseCRLF
+	rec := make([]string, len(schema.Columns))
+	if schema.Header {
+		for i, c := range schema.Columns {
+			rec[i] = c.Name
+		}
+		if err := wr.Write(rec); err != nil {
+			return err
+		}
+	}
+	for i := 0; i < rows; i++ {
+		for j, c := range schema.Columns {
+			rec[j] = g.field(c, wr.Comma, schema.Tricky)
+		}
+		if err := wr.Write(rec); err != nil {
+			return err
+		}
+	}
+	wr.Flush()
+	return wr.Error()
+}
+
+// RandomCSVFileWithSchema generates a file `name` that is a CSV table of `rows` rows following `schema`.
+func RandomCSVFileWithSchema(name string, schema CSVSchema, rows int) error {
+	return Default().RandomCSVFileWithSchema(name, schema, rows)
+}
+
+// RandomCSVFileWithSchema generates a file `name` that is a CSV table.  See RandomCSVFileWithSchema.
+func (g *Generator) RandomCSVFileWithSchema(name string, schema CSVSchema, rows int) error {
+	name = setExtension(name, "csv")
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+	return g.RandomCSV(f, schema, rows)
+}
+
+// field returns a random field of the column `c`.
+func (g *Generator) field(c Column, comma rune, tricky float64) string {
+	const defaultLen = 16
+	if c.Null > 0 && g.Float64() < c.Null {
+		return ""
+	}
+	switch c.Kind {
+	case IntColumn:
+		return strconv.FormatInt(g.int64Between(c.Min, c.Max), 10)
+	case FloatColumn:
+		return strconv.FormatFloat(c.MinFloat+g.Float64()*(c.MaxFloat-c.MinFloat), 'f', c.Decimals, 64)
+	case DateColumn:
+		layout := c.Layout
+		if layout == "" {
+			layout = time.RFC3339
+		}
+		return g.timeBetween(c.From, c.To).In(c.From.Location()).Format(layout)
+	case EnumColumn:
+		if len(c.Values) == 0 {
+			return ""
+		}
+		return g.pickString(c.Values)
+	default:
+		maxLen := c.MaxLen
+		if maxLen <= 0 {
+			maxLen = defaultLen
+		}
+		s := g.RandomAlphaString(g.IntN(maxLen)+1, c.Charset)
+		if tricky > 0 && g.Float64() < tricky {
+			pos := g.IntN(len(s) + 1)
+			for pos < len(s) && !utf8.RuneStart(s[pos]) {
+				pos++
+			}
+			s = s[:pos] + g.pickString([]string{string(comma), `"`, "\n", `""`, "\r\n"}) + s[pos:]
+		}
+		return s
+	}
+}
@@ -0,0 +1,103 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testSchema() CSVSchema {
+	from := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	return CSVSchema{
+		Columns: []Column{
+			IntCol("id", 1, 99),
+			FloatCol("price", -10, 10, 2),
+			DateCol("created", from, from.AddDate(1, 0, 0), time.DateOnly),
+			EnumCol("status", "new", "open", "closed").Nullable(0.3),
+			TextCol("comment", All, 20),
+			IntCol("big", math.MinInt64, math.MaxInt64),
+		},
+		Header: true,
+		Comma:  ';',
+		Tricky: 0.5,
+	}
+}
+
+func Test_RandomCSV(t *testing.T) {
+	require, assert := Describe(t)
+
+	var buf bytes.Buffer
+	schema := testSchema()
+	require.NoError(Gen(t).RandomCSV(&buf, schema, 200))
+	rd := csv.NewReader(&buf)
+	rd.Comma = ';'
+	records, err := rd.ReadAll()
+	require.NoError(err)
+	require.Len(records, 201)
+	assert.Equal([]string{"id", "price", "created", "status", "comment", "big"}, records[0])
+	tricky := false
+	for _, rec := range records[1:] {
+		id, err := strconv.Atoi(rec[0])
+		assert.NoError(err)
+		assert.True(id >= 1 && id <= 99)
+		p, err := strconv.ParseFloat(rec[1], 64)
+		assert.NoError(err)
+		assert.True(p >= -10 && p <= 10)
+		_, err = time.Parse(time.DateOnly, rec[2])
+		assert.NoError(err)
+		assert.Contains([]string{"", "new", "open", "closed"}, rec[3])
+		tricky = tricky || strings.ContainsAny(rec[4], ";\"\n")
+		_, err = strconv.ParseInt(rec[5], 10, 64)
+		assert.NoError(err)
+	}
+	assert.True(tricky)
+
+	from := time.Date(1500, 1, 1, 0, 0, 0, 0, time.UTC)
+	schema = CSVSchema{Columns: []Column{
+		DateCol("wide", from, from.AddDate(1000, 0, 0), "2006"),
+		DateCol("short", from, from.Add(time.Second), time.RFC3339),
+	}}
+	buf.Reset()
+	require.NoError(Gen(t).RandomCSV(&buf, schema, 200))
+	records, err = csv.NewReader(&buf).ReadAll()
+	require.NoError(err)
+	late, last := false, false
+	for _, rec := range records {
+		year, err := strconv.Atoi(rec[0])
+		require.NoError(err)
+		assert.True(year >= 1500 && year <= 2500, year)
+		// time.Duration covers only 292 years.
+		late = late || year > 1800
+		last = last || rec[1] == "1500-01-01T00:00:01Z"
+	}
+	assert.True(late)
+	assert.True(last)
+}
+
+func Test_RandomCSVFileWithSchema(t *testing.T) {
+	require, assert := Describe(t)
+
+	name := "testdata/" + RandomID() + ".csv"
+	require.NoError(RandomCSVFileWithSchema(name, testSchema(), 10))
+	defer func() { _ = os.Remove(name) }()
+	assert.FileExists(name)
+	assert.Error(RandomCSVFileWithSchema("bad/"+name, testSchema(), 10))
+	assert.Error(RandomCSV(faultyWriter{}, testSchema(), 10))
+}
+
+// faultyWriter is an io.Writer that always fails.
+type faultyWriter struct{}
+
+func (faultyWriter) Write([]byte) (int, error) {
+	return 0, io.ErrShortWrite
+}
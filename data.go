@@ -0,0 +1,282 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"io"
+	"math"
+	"sort"
+)
+
+// dataKind is the kind of data generated by a data reader.
+type dataKind int
+
+const (
+	uniformData dataKind = iota
+	entropyData
+	compressibleData
+	textData
+)
+
+const (
+	// defaultBlockSize is the default size of the blocks of a data reader.
+	defaultBlockSize = 4096
+	// maxDedupPool is the maximal number of unique blocks kept for deduplication.
+	maxDedupPool = 256
+)
+
+// englishLetters approximates the frequency of the letters in English.
+var englishLetters = NewWeightedCharset(map[rune]int{
+	'e': 127, 't': 91, 'a': 82, 'o': 75, 'i': 70, 'n': 67, 's': 63, 'h': 61, 'r': 60, 'd': 43,
+	'l': 40, 'c': 28, 'u': 28, 'm': 24, 'w': 24, 'f': 22, 'g': 20, 'y': 20, 'p': 19, 'b': 15,
+	'v': 10, 'k': 8, 'j': 2, 'x': 2, 'q': 1, 'z': 1,
+})
+
+// DataOption allows to parameterize RandomData and NewDataReader.
+type DataOption func(opts *dataOptions)
+
+type dataOptions struct {
+	kind      dataKind
+	entropy   float64 // target Shannon entropy in bits per byte.
+	ratio     float64 // target compression ratio.
+	blockSize int
+	dedup     float64 // fraction of duplicated blocks.
+}
+
+// WithEntropy generates bytes with a Shannon entropy of `bits` per byte, from 0 (a constant byte)
+// to 8 (uniform random bytes).
+func WithEntropy(bits float64) DataOption {
+	return func(do *dataOptions) {
+		do.kind, do.entropy = entropyData, bits
+	}
+}
+
+// WithCompressionRatio generates data that a general-purpose compressor, such as DEFLATE, shrinks
+// about `ratio` times.  Each block holds a random part followed by a run of a repeated byte.
+func WithCompressionRatio(ratio float64) DataOption {
+	return func(do *dataOptions) {
+		do.kind, do.ratio = compressibleData, ratio
+	}
+}
+
+// WithTextBytes generates bytes with the distribution of an English text: words of letters,
+// spaces, punctuation and new lines.
+func WithTextBytes() DataOption {
+	return func(do *dataOptions) {
+		do.kind = textData
+	}
+}
+
+// WithDedup splits the data in blocks of `blockSize` bytes.  The fraction `ratio` of the blocks are
+// copies of previous blocks.  It can be combined with the other options, which generate the unique
+// blocks.
+func WithDedup(blockSize int, ratio float64) DataOption {
+	return func(do *dataOptions) {
+		do.blockSize, do.dedup = blockSize, ratio
+	}
+}
+
+func collectDataOptions(options ...DataOption) *dataOptions {
+	opts := &dataOptions{kind: uniformData, blockSize: defaultBlockSize}
+	for _, option := range options {
+		option(opts)
+	}
+	if opts.blockSize <= 0 {
+		opts.blockSize = defaultBlockSize
+	}
+	return opts
+}
+
+// dataReader is an io.Reader generating data block per block.
+type dataReader struct {
+	g       *Generator
+	opts    *dataOptions
+	left    int64 // number of bytes still to read.  Negative means endless.
+	block   []byte
+	pool    [][]byte  // unique blocks available for deduplication.
+	cumul   []float64 // cumulative distribution of the bytes for the entropy data.
+	pending []byte    // generated text not yet used.
+}
+
+// RandomData returns `size` random bytes.  By default, the bytes are uniform and incompressible.
+// See the DataOption functions for entropy controlled, compressible, deduplicable or text-like
+// data.  If `size` is zero or negative, it returns an empty slice.
+func RandomData(size int, opts ...DataOption) []byte {
+	return Default().RandomData(size, opts...)
+}
+
+// RandomData returns `size` random bytes.  See RandomData.
+func (g *Generator) RandomData(size int, opts ...DataOption) []byte {
+	if size <= 0 {
+		return []byte{}
+	}
+	p := make([]byte, size)
+	_, _ = io.ReadFull(g.NewDataReader(int64(size), opts...), p)
+	return p
+}
+
+// NewDataReader returns a reader of `size` random bytes.  If `size` is negative, the reader never
+// ends.  The reader has the same options as RandomData.  It has its own generator seeded by the
+// default generator, thus its data do not depend on the size of the reads.
+func NewDataReader(size int64, opts ...DataOption) io.Reader {
+	return Default().NewDataReader(size, opts...)
+}
+
+// NewDataReader returns a reader of `size` random bytes.  See NewDataReader.
+func (g *Generator) NewDataReader(size int64, opts ...DataOption) io.Reader {
+	dr := &dataReader{g: NewGenerator(g.Uint64()), opts: collectDataOptions(opts...), left: size}
+	if dr.opts.kind == entropyData {
+		dr.cumul = entropyDistribution(dr.opts.entropy)
+	}
+	return dr
+}
+
+// Read implements the io.Reader interface.
+func (dr *dataReader) Read(p []byte) (int, error) {
+	if dr.left == 0 {
+		return 0, io.EOF
+	}
+	if dr.left > 0 && int64(len(p)) > dr.left {
+		p = p[:dr.left]
+	}
+	n := 0
+	for n < len(p) {
+		if len(dr.block) == 0 {
+			dr.block = dr.next()
+		}
+		c := copy(p[n:], dr.block)
+		dr.block = dr.block[c:]
+		n += c
+	}
+	if dr.left > 0 {
+		dr.left -= int64(n)
+	}
+	return n, nil
+}
+
+// next returns the next block, either a copy of a previous block or a new one.
+func (dr *dataReader) next() []byte {
+	if len(dr.pool) != 0 && dr.g.Float64() < dr.opts.dedup {
+		b := dr.pool[dr.g.IntN(len(dr.pool))]
+		return append([]byte(nil), b...)
+	}
+	b := make([]byte, dr.opts.blockSize)
+	switch dr.opts.kind {
+	case entropyData:
+		for i := range b {
+			b[i] = byte(sort.SearchFloat64s(dr.cumul, dr.g.Float64()))
+		}
+	case compressibleData:
+		dr.compressible(b)
+	case textData:
+		dr.text(b)
+	default:
+		_, _ = dr.g.Read(b)
+	}
+	if dr.opts.dedup > 0 {
+		if len(dr.pool) < maxDedupPool {
+			dr.pool = append(dr.pool, b)
+		} else {
+			dr.pool[dr.g.IntN(maxDedupPool)] = b
+		}
+		return append([]byte(nil), b...)
+	}
+	return b
+}
+
+// compressible fills `b` with random bytes followed by a run of a repeated byte so that its
+// compression ratio is about the target ratio.
+func (dr *dataReader) compressible(b []byte) {
+	n := len(b)
+	if dr.opts.ratio > 1 {
+		n = int(math.Round(float64(len(b)) / dr.opts.ratio))
+	}
+	_, _ = dr.g.Read(b[:n])
+	c := byte(dr.g.IntN(256))
+	for i := n; i < len(b); i++ {
+		b[i] = c
+	}
+}
+
+// text fills `b` with English-like text.
+func (dr *dataReader) text(b []byte) {
+	for len(dr.pending) < len(b) {
+		dr.pending = append(dr.pending, dr.textWord()...)
+	}
+	copy(b, dr.pending)
+	dr.pending = append(dr.pending[:0], dr.pending[len(b):]...)
+}
+
+// textWord returns a random word followed by a separator.
+func (dr *dataReader) textWord() string {
+	total := 0
+	for _, w := range englishWordLengths {
+		total += w
+	}
+	n, length := dr.g.IntN(total), 1
+	for i, w := range englishWordLengths {
+		if n < w {
+			length = i + 1
+			break
+		}
+		n -= w
+	}
+	word := dr.g.RandomCharsetString(length, englishLetters)
+	switch x := dr.g.IntN(100); {
+	case x < 2:
+		return word + "\n"
+	case x < 8:
+		return word + ". "
+	case x < 14:
+		return word + ", "
+	default:
+		return word + " "
+	}
+}
+
+// entropyDistribution returns the cumulative distribution of bytes which Shannon entropy is `bits`
+// per byte.  The probability of the byte i is proportional to r^i, with r found by bisection.
+func entropyDistribution(bits float64) []float64 {
+	const iterations = 60
+	weights := func(r float64) []float64 {
+		w := make([]float64, 256)
+		total := 0.0
+		for i := range w {
+			w[i] = math.Pow(r, float64(i))
+			total += w[i]
+		}
+		for i := range w {
+			w[i] /= total
+		}
+		return w
+	}
+	entropy := func(w []float64) float64 {
+		h := 0.0
+		for _, p := range w {
+			if p > 0 {
+				h -= p * math.Log2(p)
+			}
+		}
+		return h
+	}
+	lo, hi := 0.0, 1.0
+	for i := 0; i < iterations; i++ {
+		mid := (lo + hi) / 2
+		if entropy(weights(mid)) < bits {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	w := weights((lo + hi) / 2)
+	cumul := make([]float64, len(w))
+	sum := 0.0
+	for i, p := range w {
+		sum += p
+		cumul[i] = sum
+	}
+	cumul[len(cumul)-1] = 1
+	return cumul
+}
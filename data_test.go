@@ -0,0 +1,131 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"bytes"
+	"compress/flate"
+	"io"
+	"math"
+	"testing"
+)
+
+func Test_RandomData(t *testing.T) {
+	_, assert := Describe(t)
+
+	g := Gen(t)
+	p := g.RandomData(10000)
+	assert.Len(p, 10000)
+	assert.InDelta(8, shannon(p), 0.1)
+	assert.InDelta(1, compressionRatio(p), 0.05)
+	assert.Len(RandomData(0), 0)
+	assert.Len(RandomData(-1), 0)
+}
+
+func Test_RandomData_Entropy(t *testing.T) {
+	_, assert := Describe(t)
+
+	g := Gen(t)
+	for _, bits := range []float64{0, 0.5, 2, 4.5, 7, 8} {
+		p := g.RandomData(1<<16, WithEntropy(bits))
+		assert.InDelta(bits, shannon(p), 0.1, "bits %v", bits)
+	}
+}
+
+func Test_RandomData_CompressionRatio(t *testing.T) {
+	_, assert := Describe(t)
+
+	g := Gen(t)
+	for _, ratio := range []float64{1, 2, 4, 10} {
+		p := g.RandomData(1<<16, WithCompressionRatio(ratio))
+		assert.InEpsilon(ratio, compressionRatio(p), 0.15, "ratio %v", ratio)
+	}
+}
+
+func Test_RandomData_Dedup(t *testing.T) {
+	_, assert := Describe(t)
+
+	const blockSize, blocks = 512, 400
+	p := Gen(t).RandomData(blockSize*blocks, WithDedup(blockSize, 0.5))
+	seen := make(map[string]bool)
+	duplicates := 0
+	for i := 0; i < blocks; i++ {
+		b := string(p[i*blockSize : (i+1)*blockSize])
+		if seen[b] {
+			duplicates++
+		}
+		seen[b] = true
+	}
+	assert.InDelta(0.5, float64(duplicates)/blocks, 0.1)
+}
+
+func Test_RandomData_Text(t *testing.T) {
+	_, assert := Describe(t)
+
+	p := Gen(t).RandomData(5000, WithTextBytes())
+	letters := 0
+	for _, c := range p {
+		assert.Contains("abcdefghijklmnopqrstuvwxyz ,.\n", string(c))
+		if c >= 'a' && c <= 'z' {
+			letters++
+		}
+	}
+	assert.Greater(letters, 3500)
+	assert.Greater(bytes.Count(p, []byte("e")), bytes.Count(p, []byte("z")))
+	// about 4.2 bits per byte, as English text, whereas DEFLATE sometimes stores short text as is.
+	assert.InDelta(4.2, shannon(p), 0.3)
+}
+
+func Test_NewDataReader(t *testing.T) {
+	require, assert := Describe(t)
+
+	seed := Gen(t).Uint64()
+	p1, err := io.ReadAll(NewGenerator(seed).NewDataReader(10000, WithTextBytes()))
+	require.NoError(err)
+	assert.Len(p1, 10000)
+	// the data do not depend on the size of the reads.
+	rd := NewGenerator(seed).NewDataReader(10000, WithTextBytes())
+	var p2 []byte
+	buf := make([]byte, 7)
+	for {
+		n, err := rd.Read(buf)
+		p2 = append(p2, buf[:n]...)
+		if err == io.EOF {
+			break
+		}
+	}
+	assert.Equal(p1, p2)
+	assert.Equal(p1, NewGenerator(seed).RandomData(10000, WithTextBytes()))
+
+	endless := NewDataReader(-1)
+	n, err := io.CopyN(io.Discard, endless, 1<<20)
+	assert.NoError(err)
+	assert.Equal(int64(1<<20), n)
+}
+
+// shannon returns the Shannon entropy of `p` in bits per byte.
+func shannon(p []byte) float64 {
+	var counts [256]int
+	for _, c := range p {
+		counts[c]++
+	}
+	h := 0.0
+	for _, c := range counts {
+		if c > 0 {
+			f := float64(c) / float64(len(p))
+			h -= f * math.Log2(f)
+		}
+	}
+	return h
+}
+
+// compressionRatio returns the DEFLATE compression ratio of `p`.
+func compressionRatio(p []byte) float64 {
+	var b bytes.Buffer
+	w, _ := flate.NewWriter(&b, flate.DefaultCompression)
+	_, _ = w.Write(p)
+	_ = w.Close()
+	return float64(len(p)) / float64(b.Len())
+}
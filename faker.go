@@ -0,0 +1,190 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+var (
+	// ErrUnknownLocale occurs when no locale is registered with the requested tag.
+	ErrUnknownLocale = errors.New("unknown locale")
+	// ErrInvalidLocale occurs when a registered locale misses some data.
+	ErrInvalidLocale = errors.New("invalid locale")
+
+	locales   = map[string]*Locale{}
+	localesMu sync.RWMutex
+)
+
+// Locale holds the data of a territory from which a Faker draws.  The patterns replace "#" by a
+// random digit and "N" by a random non-zero digit.  The formats replace the placeholders between
+// braces.
+type Locale struct {
+	// Tag is the BCP 47 tag of the locale, for instance "fr-FR".
+	Tag string
+	// Country is the name of the country in the language of the locale.
+	Country    string
+	FirstNames []string
+	LastNames  []string
+	// NameFormat lays out {first} and {last}, for instance "{last} {first}".
+	NameFormat string
+	Streets    []string
+	// StreetNumber is the pattern of the street numbers.
+	StreetNumber string
+	Cities       []City
+	// AddressFormat lays out {number}, {street}, {city}, {region}, {postal} and {country}.
+	AddressFormat string
+	// Phones are the patterns of the phone numbers.
+	Phones []string
+	// Companies are the formats of the company names, with {last} for a last name.
+	Companies []string
+}
+
+// City is a city of a Locale.
+type City struct {
+	Name string
+	// Region is the state, the province or the prefecture of the city, if the addresses use it.
+	Region string
+	// Postal is the pattern of the postal codes of the city, for instance "750##".
+	Postal string
+}
+
+// Address is a postal address generated by a Faker.
+type Address struct {
+	Number     string
+	Street     string
+	City       string
+	Region     string
+	PostalCode string
+	Country    string
+	format     string
+}
+
+// String returns the address laid out as in its locale, on several lines.
+func (a Address) String() string {
+	return strings.NewReplacer("{number}", a.Number, "{street}", a.Street, "{city}", a.City,
+		"{region}", a.Region, "{postal}", a.PostalCode, "{country}", a.Country).Replace(a.format)
+}
+
+// RegisterLocale adds the locale `l` or replaces the locale with the same tag, including the
+// built-in ones: en-US, fr-FR, de-DE, pt-BR and ja-JP.  The lists and the formats cannot be empty.
+func RegisterLocale(l Locale) error {
+	if l.Tag == "" || len(l.FirstNames) == 0 || len(l.LastNames) == 0 || l.NameFormat == "" ||
+		len(l.Streets) == 0 || len(l.Cities) == 0 || l.AddressFormat == "" || len(l.Phones) == 0 ||
+		len(l.Companies) == 0 {
+		return fmt.Errorf("%w: %q misses some data", ErrInvalidLocale, l.Tag)
+	}
+	localesMu.Lock()
+	defer localesMu.Unlock()
+	locales[l.Tag] = &l
+	return nil
+}
+
+// Locales returns the sorted tags of the registered locales.
+func Locales() []string {
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+	tags := make([]string, 0, len(locales))
+	for tag := range locales {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+// Faker generates personal data of a locale: names, postal addresses, phone numbers and company
+// names.
+type Faker struct {
+	g *Generator
+	l *Locale
+}
+
+// NewFaker returns a Faker for the locale `tag`, such as "ja-JP".
+func NewFaker(tag string) (*Faker, error) {
+	return Default().NewFaker(tag)
+}
+
+// NewFaker returns a Faker for the locale `tag` drawing from the generator.  See NewFaker.
+func (g *Generator) NewFaker(tag string) (*Faker, error) {
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+	l, ok := locales[tag]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownLocale, tag)
+	}
+	return &Faker{g: g, l: l}, nil
+}
+
+// Locale returns the tag of the locale of the Faker.
+func (f *Faker) Locale() string {
+	return f.l.Tag
+}
+
+// FirstName returns a random first name.
+func (f *Faker) FirstName() string {
+	return f.g.pickString(f.l.FirstNames)
+}
+
+// LastName returns a random last name.
+func (f *Faker) LastName() string {
+	return f.g.pickString(f.l.LastNames)
+}
+
+// Name returns a random full name laid out as in the locale.
+func (f *Faker) Name() string {
+	return strings.NewReplacer("{first}", f.FirstName(), "{last}", f.LastName()).Replace(f.l.NameFormat)
+}
+
+// Address returns a random postal address.  The postal code matches the city.
+func (f *Faker) Address() Address {
+	c := f.l.Cities[f.g.IntN(len(f.l.Cities))]
+	return Address{
+		Number:     f.digits(f.l.StreetNumber),
+		Street:     f.g.pickString(f.l.Streets),
+		City:       c.Name,
+		Region:     c.Region,
+		PostalCode: f.digits(c.Postal),
+		Country:    f.l.Country,
+		format:     f.l.AddressFormat,
+	}
+}
+
+// Phone returns a random phone number.
+func (f *Faker) Phone() string {
+	return f.digits(f.g.pickString(f.l.Phones))
+}
+
+// Company returns a random company name.  Each placeholder of last name gets its own name.
+func (f *Faker) Company() string {
+	parts := strings.Split(f.g.pickString(f.l.Companies), "{last}")
+	var sb strings.Builder
+	for i, part := range parts {
+		if i > 0 {
+			sb.WriteString(f.LastName())
+		}
+		sb.WriteString(part)
+	}
+	return sb.String()
+}
+
+// digits replaces the "#" of `pattern` by random digits and the "N" by random non-zero digits.
+func (f *Faker) digits(pattern string) string {
+	var sb strings.Builder
+	for _, r := range pattern {
+		switch r {
+		case '#':
+			sb.WriteByte(byte('0' + f.g.IntN(10)))
+		case 'N':
+			sb.WriteByte(byte('1' + f.g.IntN(9)))
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,91 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+	"unicode"
+	"unicode/utf8"
+)
+
+func Test_NewFaker(t *testing.T) {
+	require, assert := Describe(t)
+
+	g := Gen(t)
+	postal := map[string]*regexp.Regexp{
+		"en-US": regexp.MustCompile(`, [A-Z]{2} \d{5}$`),
+		"fr-FR": regexp.MustCompile(`\n\d{5} `),
+		"de-DE": regexp.MustCompile(`\n\d{5} `),
+		"pt-BR": regexp.MustCompile(`\n\d{5}-\d{3}$`),
+		"ja-JP": regexp.MustCompile(`^〒\d{3}-\d{4}\n`),
+	}
+	for _, tag := range []string{"en-US", "fr-FR", "de-DE", "pt-BR", "ja-JP"} {
+		require.Contains(Locales(), tag)
+		f, err := g.NewFaker(tag)
+		require.NoError(err)
+		assert.Equal(tag, f.Locale())
+		for i := 0; i < loops; i++ {
+			name := f.Name()
+			assert.True(utf8.ValidString(name))
+			assert.GreaterOrEqual(len(strings.Fields(name)), 2, name)
+			a := f.Address()
+			assert.Regexp(postal[tag], a.String())
+			assert.Contains(a.String(), a.City)
+			assert.NotEmpty(a.Country)
+			phone := f.Phone()
+			assert.NotContains(phone, "#")
+			assert.GreaterOrEqual(strings.Count(phone, "")-1, 10, phone)
+			assert.NotContains(f.Company(), "{last}")
+		}
+	}
+	f, err := g.NewFaker("ja-JP")
+	require.NoError(err)
+	assert.True(unicode.Is(unicode.Han, []rune(f.LastName())[0]))
+	assert.Regexp(`[1-9]丁目[1-9]-[1-9]$`, f.Address().String())
+
+	_, err = NewFaker("xx-XX")
+	assert.ErrorIs(err, ErrUnknownLocale)
+}
+
+func Test_RegisterLocale(t *testing.T) {
+	require, assert := Describe(t)
+
+	l := Locale{
+		Tag:           "eo-001",
+		Country:       "Esperantujo",
+		FirstNames:    []string{"Zamenhof"},
+		LastNames:     []string{"Ludoviko"},
+		NameFormat:    "{first} {last}",
+		Streets:       []string{"Strato"},
+		StreetNumber:  "#",
+		Cities:        []City{{Name: "Bjalistoko", Postal: "15-###"}},
+		AddressFormat: "{street} {number}, {postal} {city}, {country}",
+		Phones:        []string{"+48 ###"},
+		Companies:     []string{"{last} kaj Filoj"},
+	}
+	require.NoError(RegisterLocale(l))
+	f, err := Gen(t).NewFaker("eo-001")
+	require.NoError(err)
+	assert.Equal("Zamenhof Ludoviko", f.Name())
+	assert.Regexp(`^Strato \d, 15-\d{3} Bjalistoko, Esperantujo$`, f.Address().String())
+	assert.Equal("Ludoviko kaj Filoj", f.Company())
+
+	l.Tag, l.LastNames, l.Companies = "eo-002", []string{"Ludoviko", "Klara"}, []string{"{last} & {last}"}
+	require.NoError(RegisterLocale(l))
+	f, err = Gen(t).NewFaker("eo-002")
+	require.NoError(err)
+	differ := false
+	for i := 0; i < loops && !differ; i++ {
+		names := strings.Split(f.Company(), " & ")
+		require.Len(names, 2)
+		differ = names[0] != names[1]
+	}
+	assert.True(differ)
+
+	l.Phones = nil
+	assert.ErrorIs(RegisterLocale(l), ErrInvalidLocale)
+}
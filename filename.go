@@ -0,0 +1,373 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// ErrInvalidFileName occurs when a file name or a path is invalid on the platform.
+var ErrInvalidFileName = errors.New("invalid file name")
+
+// Platform selects the file name rules of RandomFileName and CheckFileName.
+type Platform int
+
+const (
+	// POSIX requests the rules of Linux and the other POSIX systems: any byte but "/" and NUL, at most
+	// 255 bytes.
+	POSIX Platform = iota
+	// Windows requests the rules of Win32: no control character, no `<>:"/\|?*`, no trailing dot or
+	// space, no reserved DOS name such as CON or NUL, at most 255 UTF-16 code units.
+	Windows
+	// Portable requests names valid on both POSIX and Windows.  An invalid portable name is invalid on
+	// at least one of them.
+	Portable
+)
+
+const (
+	maxPOSIXName   = 255 // max number of bytes of a POSIX file name.
+	maxWindowsName = 255 // max number of UTF-16 code units of a Windows file name.
+	// windowsForbidden are the printable characters forbidden in Windows file names.
+	windowsForbidden = `<>:"/\|?*`
+)
+
+var (
+	// reservedNames are the DOS device names Windows reserves, with or without extension.
+	reservedNames = []string{"CON", "PRN", "AUX", "NUL", "COM1", "COM2", "COM3", "COM4", "COM5", "COM6",
+		"COM7", "COM8", "COM9", "LPT1", "LPT2", "LPT3", "LPT4", "LPT5", "LPT6", "LPT7", "LPT8", "LPT9"}
+
+	// fileExtensions are the extensions of the generated file names.
+	fileExtensions = []string{"txt", "go", "json", "csv", "log", "md", "png", "jpg", "pdf", "tar.gz", "yaml"}
+
+	// decomposable are lower case letters with a canonical decomposition, used to build names that
+	// differ by normalization.
+	decomposable = []string{"é", "è", "ê", "ü", "ö", "ñ", "ç", "å", "ō", "č"}
+)
+
+// String returns the name of the platform.
+func (p Platform) String() string {
+	switch p {
+	case POSIX:
+		return "posix"
+	case Windows:
+		return "windows"
+	case Portable:
+		return "portable"
+	}
+	return fmt.Sprintf("platform(%d)", int(p))
+}
+
+// RandomFileName returns a random file name, without directory, according to `v` and the rules of
+// `p`.  Edge cases include maximal lengths, hidden names, leading dashes and spaces, non-ASCII
+// letters and, for POSIX, the characters Windows forbids.  Invalid names include empty, "." and ".."
+// names, separators, NUL and control characters, over-long names, reserved DOS names like CON or
+// NUL, and trailing dots or spaces.  CheckFileName checks them without a file system.
+func RandomFileName(p Platform, v Validity) string {
+	return Default().RandomFileName(p, v)
+}
+
+// RandomFileName returns a random file name according to `v`.  See RandomFileName.
+func (g *Generator) RandomFileName(p Platform, v Validity) string {
+	switch v {
+	case EdgeCase:
+		return g.edgeFileName(p)
+	case Invalid:
+		return g.invalidFileName(p, false)
+	default:
+		return g.validFileName()
+	}
+}
+
+// RandomFilePath returns a random relative or absolute path of one to four components, according to
+// `v` and the rules of `p`.  The Windows paths use backslashes and may start with a drive letter or
+// a UNC prefix.  An edge case path holds one edge case component, an invalid path one invalid
+// component.
+func RandomFilePath(p Platform, v Validity) string {
+	return Default().RandomFilePath(p, v)
+}
+
+// RandomFilePath returns a random path according to `v`.  See RandomFilePath.
+func (g *Generator) RandomFilePath(p Platform, v Validity) string {
+	n := g.between(1, 4)
+	names := make([]string, n)
+	for i := range names {
+		names[i] = g.validFileName()
+	}
+	i := g.IntN(n)
+	switch v {
+	case EdgeCase:
+		names[i] = g.edgeFileName(p)
+	case Invalid:
+		names[i] = g.invalidFileName(p, true)
+	}
+	if p != Windows {
+		prefix := ""
+		if p == POSIX && g.IntN(2) == 0 {
+			prefix = "/"
+		}
+		return prefix + strings.Join(names, "/")
+	}
+	prefix := g.pick(
+		func() string { return "" },
+		func() string { return g.RandomAlphaString(1, Caps) + `:\` },
+		func() string { return `\\` + strings.ToLower(g.label(g.between(1, 15))) + `\` + g.fileStem() + `\` },
+	)
+	return prefix + strings.Join(names, `\`)
+}
+
+// RandomCollidingFileNames returns two distinct portable file names that differ only by the case of
+// letters or by the Unicode normalization form, NFC versus NFD.  They designate the same file on
+// case-insensitive file systems, such as the default ones of Windows and macOS, or on
+// normalization-insensitive ones, but two files on Linux.
+func RandomCollidingFileNames() (string, string) {
+	return Default().RandomCollidingFileNames()
+}
+
+// RandomCollidingFileNames returns two distinct colliding file names.  See RandomCollidingFileNames.
+func (g *Generator) RandomCollidingFileNames() (string, string) {
+	stem := g.RandomAlphaString(g.between(1, 6), Small) + g.pickString(decomposable) +
+		g.RandomAlphaString(g.between(1, 6), Small)
+	name := stem + "." + g.pickString(fileExtensions)
+	mutator := CaseMutator()
+	if g.IntN(2) == 0 {
+		mutator = NormalizationMutator()
+	}
+	other, _ := g.MutateString(name, mutator)
+	return name, other
+}
+
+// CheckFileName checks that `name` is a valid file name, without directory, on the platform `p`.
+// Otherwise, it returns an error wrapping ErrInvalidFileName with the reason.  It applies the rules
+// of the platform as strings, hence works on any system.
+func CheckFileName(name string, p Platform) error {
+	switch name {
+	case "":
+		return fmt.Errorf("%w: empty name", ErrInvalidFileName)
+	case ".", "..":
+		return fmt.Errorf("%w: %q is a directory entry", ErrInvalidFileName, name)
+	}
+	if i := strings.IndexAny(name, "/\x00"); i >= 0 {
+		return fmt.Errorf("%w: %q at byte %d", ErrInvalidFileName, name[i], i)
+	}
+	if p != Windows && len(name) > maxPOSIXName {
+		return fmt.Errorf("%w: %d bytes instead of at most %d", ErrInvalidFileName, len(name), maxPOSIXName)
+	}
+	if p != POSIX {
+		return checkWindowsName(name)
+	}
+	return nil
+}
+
+// CheckFilePath checks that each component of `path` is a valid file name on the platform `p`, the
+// "." and ".." components being allowed.  On Windows, both slashes and backslashes separate the
+// components, and the path may start with a drive letter or a UNC prefix.  It does not check the
+// total length, which depends on the system.
+func CheckFilePath(path string, p Platform) error {
+	if path == "" {
+		return fmt.Errorf("%w: empty path", ErrInvalidFileName)
+	}
+	if p == Portable {
+		if err := CheckFilePath(path, POSIX); err != nil {
+			return err
+		}
+		return CheckFilePath(path, Windows)
+	}
+	separators := "/"
+	if p == Windows {
+		separators = `/\`
+		path = trimWindowsPrefix(path)
+	}
+	for _, name := range strings.FieldsFunc(path, func(r rune) bool { return strings.ContainsRune(separators, r) }) {
+		if name == "." || name == ".." {
+			continue
+		}
+		if err := CheckFileName(name, p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkWindowsName checks the Windows specific rules of a file name.
+func checkWindowsName(name string) error {
+	for i, r := range name {
+		if r < ' ' || strings.ContainsRune(windowsForbidden, r) {
+			return fmt.Errorf("%w: %q at byte %d is forbidden on Windows", ErrInvalidFileName, r, i)
+		}
+	}
+	if last := name[len(name)-1]; last == '.' || last == ' ' {
+		return fmt.Errorf("%w: trailing %q on Windows", ErrInvalidFileName, last)
+	}
+	if isReservedName(name) {
+		return fmt.Errorf("%w: %q is a reserved name on Windows", ErrInvalidFileName, name)
+	}
+	if n := utf16Len(name); n > maxWindowsName {
+		return fmt.Errorf("%w: %d UTF-16 code units instead of at most %d", ErrInvalidFileName, n, maxWindowsName)
+	}
+	return nil
+}
+
+// isReservedName reports whether the part of `name` before the first dot is a reserved DOS name,
+// ignoring the case and the trailing spaces.
+func isReservedName(name string) bool {
+	stem := name
+	if i := strings.IndexByte(name, '.'); i >= 0 {
+		stem = name[:i]
+	}
+	stem = strings.TrimRight(stem, " ")
+	for _, r := range reservedNames {
+		if strings.EqualFold(stem, r) {
+			return true
+		}
+	}
+	return false
+}
+
+// trimWindowsPrefix removes the drive letter or the UNC prefix of `path`.
+func trimWindowsPrefix(path string) string {
+	if len(path) >= 2 && path[1] == ':' && (path[0]|0x20 >= 'a' && path[0]|0x20 <= 'z') {
+		return path[2:]
+	}
+	if strings.HasPrefix(path, `\\`) {
+		parts := strings.SplitN(path[2:], `\`, 3)
+		if len(parts) == 3 {
+			return parts[2]
+		}
+		return ""
+	}
+	return path
+}
+
+// utf16Len returns the number of UTF-16 code units encoding `s`.
+func utf16Len(s string) int {
+	n := 0
+	for _, r := range s {
+		n++
+		if r > 0xFFFF {
+			n++
+		}
+	}
+	return n
+}
+
+// validFileName returns an ordinary portable file name.
+func (g *Generator) validFileName() string {
+	return g.pick(
+		func() string { return g.fileStem() + "." + g.pickString(fileExtensions) },
+		func() string { return g.fileStem() + "_" + g.fileStem() + "." + g.pickString(fileExtensions) },
+		func() string { return g.fileStem() },
+	)
+}
+
+// fileStem returns lower case alphanumerical characters that are not a reserved name.
+func (g *Generator) fileStem() string {
+	for {
+		s := strings.ToLower(g.RandomAlphaString(g.between(1, 12), AlphaNumNoSpace))
+		if !isReservedName(s) {
+			return s
+		}
+	}
+}
+
+// edgeFileName returns a valid but unusual file name on `p`.
+func (g *Generator) edgeFileName(p Platform) string {
+	fs := []func() string{
+		func() string { return g.RandomAlphaString(maxPOSIXName, Small) },
+		func() string { return "." + g.fileStem() },
+		func() string { return "-" + g.fileStem() },
+		func() string { return " " + g.validFileName() },
+		func() string { return g.fileStem() + " " + g.validFileName() },
+		func() string {
+			return g.RandomAlphaString(g.between(1, 20), MultiByte) + "." + g.pickString(fileExtensions)
+		},
+		func() string { return g.fileStem() + "..." + g.pickString(fileExtensions) },
+		func() string { return g.pickString(reservedNames) + g.fileStem() },
+		func() string { return strings.ToLower(g.pickString(reservedNames)) + "-" + g.validFileName() },
+	}
+	switch p {
+	case POSIX:
+		fs = append(fs,
+			func() string { return g.RandomAlphaStringSized(maxPOSIXName, Bytes, MultiByte) },
+			func() string { return g.fileStem() + g.pickString(strings.Split(`<>:"\|?*`, "")) + g.fileStem() },
+			func() string { return g.fileStem() + string(rune(g.between(1, 0x1f))) + g.fileStem() },
+			func() string { return g.fileStem() + g.pickString([]string{".", " ", "..."}) },
+			func() string { return g.pickString(reservedNames) },
+			func() string { return "..." },
+		)
+	case Windows:
+		fs = append(fs,
+			func() string { return g.cjk(maxWindowsName) },
+			func() string { return strings.ToUpper(g.RandomAlphaString(6, Small)) + "~1" },
+		)
+	}
+	return g.pick(fs...)
+}
+
+// invalidFileName returns a file name invalid on `p`.  Within a path, it avoids the names that
+// remain valid as components, such as "", "..", or a name with a separator.
+func (g *Generator) invalidFileName(p Platform, inPath bool) string {
+	fs := []func() string{
+		func() string { return g.fileStem() + "\x00" + g.validFileName() },
+		func() string { return g.validFileName() + "\x00" },
+	}
+	if !inPath {
+		fs = append(fs,
+			func() string { return "" },
+			func() string { return "." },
+			func() string { return ".." },
+			func() string { return g.fileStem() + "/" + g.validFileName() },
+		)
+	}
+	if p != Windows {
+		fs = append(fs,
+			func() string { return g.RandomAlphaString(g.between(maxPOSIXName+1, maxPOSIXName+64), Small) },
+			// valid on Windows, but 3 bytes per character in UTF-8.
+			func() string { return g.cjk(g.between(maxPOSIXName/3+1, maxWindowsName)) },
+		)
+	}
+	if p != POSIX {
+		forbidden := strings.Replace(windowsForbidden, "/", "", 1)
+		if inPath {
+			// a backslash is a separator, and a colon after a first letter is a drive.
+			forbidden = strings.NewReplacer(`\`, "", ":", "").Replace(forbidden)
+		}
+		fs = append(fs,
+			func() string { return g.mixedCase(g.pickString(reservedNames)) },
+			func() string { return g.mixedCase(g.pickString(reservedNames)) + "." + g.pickString(fileExtensions) },
+			func() string { return g.pickString(reservedNames) + " ." + g.pickString(fileExtensions) },
+			func() string { return g.validFileName() + g.pickString([]string{".", " ", "..", " .", ". "}) },
+			func() string {
+				return g.fileStem() + string(forbidden[g.IntN(len(forbidden))]) + g.validFileName()
+			},
+			func() string { return g.fileStem() + string(rune(g.between(1, 0x1f))) + g.validFileName() },
+			func() string { return g.RandomAlphaString(g.between(maxWindowsName+1, maxWindowsName+64), Small) },
+		)
+	}
+	return g.pick(fs...)
+}
+
+// mixedCase returns the upper case ASCII `s` with each letter in random case.
+func (g *Generator) mixedCase(s string) string {
+	b := []byte(s)
+	for i := range b {
+		if g.IntN(2) == 0 {
+			b[i] |= 0x20 // lower case letter; the digits already have this bit.
+		}
+	}
+	return string(b)
+}
+
+// cjk returns `n` random CJK ideographs, each 3 bytes in UTF-8 and one UTF-16 code unit.
+func (g *Generator) cjk(n int) string {
+	var sb strings.Builder
+	sb.Grow(n * utf8.UTFMax)
+	for i := 0; i < n; i++ {
+		sb.WriteRune(rune(0x4E00 + g.IntN(0x5000)))
+	}
+	return sb.String()
+}
@@ -0,0 +1,161 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func Test_RandomFileName(t *testing.T) {
+	_, assert := Describe(t)
+
+	g := Gen(t)
+	for _, p := range []Platform{POSIX, Windows, Portable} {
+		for i := 0; i < loops; i++ {
+			n := g.RandomFileName(p, Valid)
+			assert.NoError(CheckFileName(n, Portable), "%s %+q", p, n)
+			n = g.RandomFileName(p, EdgeCase)
+			assert.NoError(CheckFileName(n, p), "%s %+q", p, n)
+			n = g.RandomFileName(p, Invalid)
+			assert.True(errors.Is(CheckFileName(n, p), ErrInvalidFileName), "%s %+q", p, n)
+		}
+	}
+}
+
+func Test_RandomFileName_onLinux(t *testing.T) {
+	_, assert := Describe(t)
+
+	skipUnlessLinux(t)
+	g := Gen(t)
+	dir := t.TempDir()
+	for i := 0; i < loops; i++ {
+		n := g.RandomFileName(POSIX, EdgeCase)
+		assert.NoError(os.WriteFile(filepath.Join(dir, n), nil, 0o600), "%+q", n)
+		n = g.RandomFileName(POSIX, Invalid)
+		if n == "" || n == "." || n == ".." || strings.Contains(n, "/") {
+			// designates an existing directory or a missing one.
+			continue
+		}
+		assert.Error(os.WriteFile(filepath.Join(dir, n), nil, 0o600), "%+q", n)
+	}
+}
+
+func Test_RandomFilePath(t *testing.T) {
+	_, assert := Describe(t)
+
+	g := Gen(t)
+	for _, p := range []Platform{POSIX, Windows, Portable} {
+		for i := 0; i < loops; i++ {
+			path := g.RandomFilePath(p, Valid)
+			assert.NoError(CheckFilePath(path, p), "%s %+q", p, path)
+			path = g.RandomFilePath(p, EdgeCase)
+			assert.NoError(CheckFilePath(path, p), "%s %+q", p, path)
+			path = g.RandomFilePath(p, Invalid)
+			assert.Error(CheckFilePath(path, p), "%s %+q", p, path)
+		}
+	}
+}
+
+func Test_RandomCollidingFileNames(t *testing.T) {
+	_, assert := Describe(t)
+
+	g := Gen(t)
+	for i := 0; i < loops; i++ {
+		a, b := g.RandomCollidingFileNames()
+		assert.NotEqual(a, b)
+		assert.NoError(CheckFileName(a, Portable), "%+q", a)
+		assert.NoError(CheckFileName(b, Portable), "%+q", b)
+		assert.True(strings.EqualFold(a, b) || nfc(b) == a, "%+q %+q", a, b)
+	}
+
+	skipUnlessLinux(t)
+	dir := t.TempDir()
+	for i := 0; i < loops; i++ {
+		a, b := g.RandomCollidingFileNames()
+		sub := filepath.Join(dir, RandomID())
+		assert.NoError(os.Mkdir(sub, 0o700))
+		assert.NoError(os.WriteFile(filepath.Join(sub, a), []byte("a"), 0o600))
+		assert.NoError(os.WriteFile(filepath.Join(sub, b), []byte("b"), 0o600))
+		entries, err := os.ReadDir(sub)
+		assert.NoError(err)
+		assert.Len(entries, 2)
+	}
+}
+
+func Test_CheckFileName(t *testing.T) {
+	_, assert := Describe(t)
+
+	tcs := []struct {
+		name    string
+		posix   bool
+		windows bool
+	}{
+		{"report.txt", true, true},
+		{".hidden", true, true},
+		{"", false, false},
+		{"..", false, false},
+		{"a/b", false, false},
+		{"a\x00b", false, false},
+		{"CON", true, false},
+		{"nul.txt", true, false},
+		{"Com1 .log", true, false},
+		{"console", true, true},
+		{"file.", true, false},
+		{"file ", true, false},
+		{"a:b", true, false},
+		{"a\nb", true, false},
+		{strings.Repeat("a", 255), true, true},
+		{strings.Repeat("a", 256), false, false},
+		{strings.Repeat("日", 255), false, true},
+	}
+	for _, tc := range tcs {
+		assert.Equal(tc.posix, CheckFileName(tc.name, POSIX) == nil, "%+q", tc.name)
+		assert.Equal(tc.windows, CheckFileName(tc.name, Windows) == nil, "%+q", tc.name)
+		assert.Equal(tc.posix && tc.windows, CheckFileName(tc.name, Portable) == nil, "%+q", tc.name)
+	}
+}
+
+func Test_CheckFilePath(t *testing.T) {
+	_, assert := Describe(t)
+
+	assert.NoError(CheckFilePath("/usr/../local/./bin", POSIX))
+	assert.NoError(CheckFilePath(`C:\Users\a:b`, POSIX))
+	assert.Error(CheckFilePath(`C:\Users\a:b`, Windows))
+	assert.NoError(CheckFilePath(`C:\Users\me/file.txt`, Windows))
+	assert.NoError(CheckFilePath(`\\server\share\dir\file.txt`, Windows))
+	assert.Error(CheckFilePath(`dir\nul.txt`, Windows))
+	assert.Error(CheckFilePath("dir/a\x00", POSIX))
+	assert.Error(CheckFilePath("", Portable))
+}
+
+// skipUnlessLinux skips the test on the systems other than Linux, whose file systems may reject the
+// POSIX names or ignore the case and the normalization.
+func skipUnlessLinux(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("the file system checks run only on Linux")
+	}
+}
+
+// nfc returns `s` with its decomposed letters composed.
+func nfc(s string) string {
+	var sb strings.Builder
+	runes := []rune(s)
+	for i := 0; i < len(runes); i++ {
+		if i+1 < len(runes) {
+			if c, ok := composed[string(runes[i:i+2])]; ok {
+				sb.WriteRune(c)
+				i++
+				continue
+			}
+		}
+		sb.WriteRune(runes[i])
+	}
+	return sb.String()
+}
@@ -0,0 +1,495 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrNotPointer occurs when Fill does not receive a non-nil pointer.
+	ErrNotPointer = errors.New("fill needs a non-nil pointer")
+	// ErrBadTag occurs when a `test:` struct tag is malformed.
+	ErrBadTag = errors.New("bad test tag")
+)
+
+// tagCharsets maps the charset names of the `test:` struct tag to their type.
+var tagCharsets = map[string]AlphaNumType{
+	"all": All, "allcvs": AllCVS, "alphanum": AlphaNum, "alphanumnospace": AlphaNumNoSpace,
+	"alpha": Alpha, "alphanospace": AlphaNoSpace, "caps": Caps, "small": Small, "hex": Hex,
+	"ldh": LDH, "atext": AText, "unreserved": Unreserved, "multibyte": MultiByte, "emoji": Emoji,
+	"combining": Combining, "rtl": RTL, "zerowidth": ZeroWidth, "invalidutf8": InvalidUTF8,
+	"digits": Digits, "base32": Base32, "base64": Base64,
+}
+
+// tagGenerators maps the generator names of the `test:` struct tag to their generator.
+var tagGenerators = map[string]func(g *Generator, v Validity) string{
+	"email":    (*Generator).RandomEmail,
+	"url":      (*Generator).RandomURL,
+	"hostname": (*Generator).RandomHostname,
+	"ipv4":     (*Generator).RandomIPv4,
+	"ipv6":     (*Generator).RandomIPv6,
+	"cidr":     (*Generator).RandomCIDR,
+	"mac":      (*Generator).RandomMAC,
+	"id":       func(g *Generator, _ Validity) string { return g.RandomID() },
+	"text":     func(g *Generator, _ Validity) string { return g.RandomText(WithLorem()) },
+}
+
+// FillOption allows to parameterize Fill.
+type FillOption func(opts *fillOptions)
+
+type fillOptions struct {
+	maxDepth     int
+	maxRecursion int
+}
+
+// WithMaxDepth sets the maximal nesting of the filled values.  Deeper pointers, slices and maps
+// stay nil.  The default is 8.
+func WithMaxDepth(n int) FillOption {
+	return func(fo *fillOptions) {
+		fo.maxDepth = n
+	}
+}
+
+// WithMaxRecursion sets how many times a recursive type may nest in itself, for instance the nodes
+// of a linked list.  The default is 2.
+func WithMaxRecursion(n int) FillOption {
+	return func(fo *fillOptions) {
+		fo.maxRecursion = n
+	}
+}
+
+func collectFillOptions(options ...FillOption) *fillOptions {
+	opts := &fillOptions{maxDepth: 8, maxRecursion: 2}
+	for _, option := range options {
+		option(opts)
+	}
+	return opts
+}
+
+// fillTag is a parsed `test:` struct tag.
+type fillTag struct {
+	skip     bool
+	charset  AlphaNumType
+	gen      func(g *Generator, v Validity) string
+	validity Validity
+	minLen   int
+	maxLen   int
+	min, max string
+	pattern  string
+}
+
+// filler holds the state of a Fill.
+type filler struct {
+	g     *Generator
+	opts  *fillOptions
+	stack map[reflect.Type]int // number of occurrences of each struct type being filled.
+}
+
+// Fill fills the value pointed by `ptr` with random data.  It handles the structs, slices, arrays,
+// maps, pointers, time.Time and the basic types.  The unexported fields, interfaces, channels and
+// functions are left untouched.
+//
+// The struct tag `test:` controls the value of a field with comma-separated keys:
+//   - a character set name such as alpha, alphanum, caps, hex or emoji (see AlphaNumType),
+//   - a generator name: email, url, hostname, ipv4, ipv6, cidr, mac, id or text,
+//   - edge or invalid to request edge case or invalid values from the generators,
+//   - len=n, or minlen=n and maxlen=n, for the length of strings, slices and maps,
+//   - min=x and max=x for the range of numbers and times (RFC 3339),
+//   - pattern=regexp for a string matching regexp.  It must be the last key,
+//   - "-" to skip the field.
+//
+// For instance `test:"alpha,len=12"`, `test:"min=1,max=99"` or `test:"email"`.
+func Fill(ptr interface{}, opts ...FillOption) error {
+	return Default().Fill(ptr, opts...)
+}
+
+// Fill fills the value pointed by `ptr` with random data.  See Fill.
+func (g *Generator) Fill(ptr interface{}, opts ...FillOption) error {
+	v := reflect.ValueOf(ptr)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return ErrNotPointer
+	}
+	f := &filler{g: g, opts: collectFillOptions(opts...), stack: map[reflect.Type]int{}}
+	return f.fill(v.Elem(), defaultFillTag(), 0)
+}
+
+// defaultFillTag returns the tag of a field without `test:` struct tag.
+func defaultFillTag() *fillTag {
+	return &fillTag{charset: AlphaNum, minLen: -1, maxLen: -1}
+}
+
+// parseFillTag parses the `test:` struct tag `tag`.
+func parseFillTag(tag string) (*fillTag, error) {
+	ft := defaultFillTag()
+	if tag == "-" {
+		ft.skip = true
+		return ft, nil
+	}
+	for tag != "" {
+		var key string
+		if strings.HasPrefix(tag, "pattern=") {
+			key, tag = tag, ""
+		} else if i := strings.IndexByte(tag, ','); i >= 0 {
+			key, tag = tag[:i], tag[i+1:]
+		} else {
+			key, tag = tag, ""
+		}
+		if err := ft.parseKey(strings.TrimSpace(key)); err != nil {
+			return nil, err
+		}
+	}
+	return ft, nil
+}
+
+// parseKey parses one key of a `test:` struct tag.
+func (ft *fillTag) parseKey(key string) error {
+	name, value, hasValue := strings.Cut(key, "=")
+	if !hasValue {
+		if t, ok := tagCharsets[name]; ok {
+			ft.charset = t
+			return nil
+		}
+		if gen, ok := tagGenerators[name]; ok {
+			ft.gen = gen
+			return nil
+		}
+		switch name {
+		case "edge":
+			ft.validity = EdgeCase
+		case "invalid":
+			ft.validity = Invalid
+		case "":
+		default:
+			return fmt.Errorf("%w: unknown key %q", ErrBadTag, name)
+		}
+		return nil
+	}
+	var err error
+	switch name {
+	case "len":
+		ft.minLen, err = strconv.Atoi(value)
+		ft.maxLen = ft.minLen
+	case "minlen":
+		ft.minLen, err = strconv.Atoi(value)
+	case "maxlen":
+		ft.maxLen, err = strconv.Atoi(value)
+	case "min":
+		ft.min = value
+	case "max":
+		ft.max = value
+	case "pattern":
+		ft.pattern = value
+	default:
+		return fmt.Errorf("%w: unknown key %q", ErrBadTag, name)
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrBadTag, err)
+	}
+	return nil
+}
+
+// length returns a random length respecting the tag.
+func (f *filler) length(ft *fillTag, defaultMax int) int {
+	minimum, maximum := 1, defaultMax
+	if ft.minLen >= 0 {
+		minimum = ft.minLen
+		if maximum < minimum {
+			maximum = minimum + defaultMax
+		}
+	}
+	if ft.maxLen >= 0 {
+		maximum = ft.maxLen
+	}
+	return f.g.between(minimum, maximum)
+}
+
+// fill fills `v` according to the tag `ft`.
+func (f *filler) fill(v reflect.Value, ft *fillTag, depth int) error {
+	if v.Type() == reflect.TypeOf(time.Time{}) {
+		return f.fillTime(v, ft)
+	}
+	switch v.Kind() {
+	case reflect.Struct:
+		return f.fillStruct(v, depth)
+	case reflect.Ptr:
+		if !f.enter(v.Type().Elem(), depth) {
+			return nil
+		}
+		p := reflect.New(v.Type().Elem())
+		if err := f.fill(p.Elem(), ft, depth+1); err != nil {
+			return err
+		}
+		v.Set(p)
+	case reflect.Slice:
+		return f.fillSlice(v, ft, depth)
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := f.fill(v.Index(i), ft, depth+1); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		return f.fillMap(v, ft, depth)
+	case reflect.String:
+		s, err := f.str(ft)
+		if err != nil {
+			return err
+		}
+		v.SetString(s)
+	case reflect.Bool:
+		v.SetBool(f.g.IntN(2) == 0)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return f.fillInt(v, ft)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return f.fillUint(v, ft)
+	case reflect.Float32, reflect.Float64:
+		x, err := f.float(ft)
+		if err != nil {
+			return err
+		}
+		v.SetFloat(x)
+	case reflect.Complex64, reflect.Complex128:
+		re, err := f.float(ft)
+		if err != nil {
+			return err
+		}
+		im, err := f.float(ft)
+		if err != nil {
+			return err
+		}
+		v.SetComplex(complex(re, im))
+	default:
+		// interfaces, channels and functions are left untouched.
+	}
+	return nil
+}
+
+// enter returns true if a value of type `t` may be created at `depth`.
+func (f *filler) enter(t reflect.Type, depth int) bool {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Array || t.Kind() == reflect.Map {
+		t = t.Elem()
+	}
+	return depth < f.opts.maxDepth && f.stack[t] < f.opts.maxRecursion
+}
+
+// fillStruct fills the exported fields of the struct `v`.
+func (f *filler) fillStruct(v reflect.Value, depth int) error {
+	t := v.Type()
+	f.stack[t]++
+	defer func() { f.stack[t]-- }()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.PkgPath != "" {
+			continue
+		}
+		ft, err := parseFillTag(sf.Tag.Get("test"))
+		if err != nil {
+			return fmt.Errorf("field %s: %w", sf.Name, err)
+		}
+		if ft.skip {
+			continue
+		}
+		if err = f.fill(v.Field(i), ft, depth+1); err != nil {
+			return fmt.Errorf("field %s: %w", sf.Name, err)
+		}
+	}
+	return nil
+}
+
+// fillSlice fills the slice `v` with random elements.  The length of the tag applies to the slice,
+// the other keys to the elements.  A []byte is filled with random bytes.
+func (f *filler) fillSlice(v reflect.Value, ft *fillTag, depth int) error {
+	const defaultMax = 4
+	if !f.enter(v.Type().Elem(), depth) {
+		return nil
+	}
+	if v.Type().Elem().Kind() == reflect.Uint8 && ft.min == "" && ft.max == "" {
+		// RandomSlice draws a random length for 0.
+		if n := f.length(ft, 2*defaultLength); n > 0 {
+			v.SetBytes(f.g.RandomSlice(n))
+		} else {
+			v.SetBytes([]byte{})
+		}
+		return nil
+	}
+	n := f.length(ft, defaultMax)
+	s := reflect.MakeSlice(v.Type(), n, n)
+	elemTag := *ft
+	elemTag.minLen, elemTag.maxLen = -1, -1
+	for i := 0; i < n; i++ {
+		if err := f.fill(s.Index(i), &elemTag, depth+1); err != nil {
+			return err
+		}
+	}
+	v.Set(s)
+	return nil
+}
+
+// fillMap fills the map `v` with random keys and elements.  The length of the tag applies to the map,
+// the other keys to the keys and the elements.
+func (f *filler) fillMap(v reflect.Value, ft *fillTag, depth int) error {
+	const defaultMax = 4
+	if !f.enter(v.Type().Elem(), depth) {
+		return nil
+	}
+	n := f.length(ft, defaultMax)
+	m := reflect.MakeMapWithSize(v.Type(), n)
+	elemTag := *ft
+	elemTag.minLen, elemTag.maxLen = -1, -1
+	for i := 0; i < n; i++ {
+		k := reflect.New(v.Type().Key()).Elem()
+		if err := f.fill(k, &elemTag, depth+1); err != nil {
+			return err
+		}
+		e := reflect.New(v.Type().Elem()).Elem()
+		if err := f.fill(e, &elemTag, depth+1); err != nil {
+			return err
+		}
+		m.SetMapIndex(k, e)
+	}
+	v.Set(m)
+	return nil
+}
+
+// str returns a random string respecting the tag.
+func (f *filler) str(ft *fillTag) (string, error) {
+	switch {
+	case ft.pattern != "":
+		return f.g.RandomMatching(ft.pattern)
+	case ft.gen != nil:
+		return ft.gen(f.g, ft.validity), nil
+	}
+	n := f.length(ft, defaultLength)
+	if n == 0 {
+		return "", nil
+	}
+	return f.g.RandomAlphaString(n, ft.charset), nil
+}
+
+// fillInt fills the signed integer `v` within the range of its type and of the tag.
+func (f *filler) fillInt(v reflect.Value, ft *fillTag) error {
+	bits := uint(v.Type().Bits())
+	lo, hi := int64(-1)<<(bits-1), int64(uint64(1)<<(bits-1)-1)
+	var err error
+	if ft.min != "" {
+		if lo, err = strconv.ParseInt(ft.min, 10, int(bits)); err != nil {
+			return fmt.Errorf("%w: %v", ErrBadTag, err)
+		}
+	}
+	if ft.max != "" {
+		if hi, err = strconv.ParseInt(ft.max, 10, int(bits)); err != nil {
+			return fmt.Errorf("%w: %v", ErrBadTag, err)
+		}
+	}
+	if lo > hi {
+		return fmt.Errorf("%w: min > max", ErrBadTag)
+	}
+	v.SetInt(f.g.int64Between(lo, hi))
+	return nil
+}
+
+// fillUint fills the unsigned integer `v` within the range of its type and of the tag.
+func (f *filler) fillUint(v reflect.Value, ft *fillTag) error {
+	bits := uint(v.Type().Bits())
+	lo, hi := uint64(0), uint64(math.MaxUint64)>>(64-bits)
+	var err error
+	if ft.min != "" {
+		if lo, err = strconv.ParseUint(ft.min, 10, int(bits)); err != nil {
+			return fmt.Errorf("%w: %v", ErrBadTag, err)
+		}
+	}
+	if ft.max != "" {
+		if hi, err = strconv.ParseUint(ft.max, 10, int(bits)); err != nil {
+			return fmt.Errorf("%w: %v", ErrBadTag, err)
+		}
+	}
+	if lo > hi {
+		return fmt.Errorf("%w: min > max", ErrBadTag)
+	}
+	if hi-lo == math.MaxUint64 {
+		v.SetUint(f.g.Uint64())
+		return nil
+	}
+	v.SetUint(lo + f.g.Uint64N(hi-lo+1))
+	return nil
+}
+
+// float returns a random float within the range of the tag, by default -1e6 to 1e6.  A lone bound
+// sets the other one 2e6 away.
+func (f *filler) float(ft *fillTag) (float64, error) {
+	const span = 1e6
+	lo, hi := -span, span
+	var err error
+	if ft.min != "" {
+		if lo, err = strconv.ParseFloat(ft.min, 64); err != nil {
+			return 0, fmt.Errorf("%w: %v", ErrBadTag, err)
+		}
+		hi = lo + 2*span
+	}
+	if ft.max != "" {
+		if hi, err = strconv.ParseFloat(ft.max, 64); err != nil {
+			return 0, fmt.Errorf("%w: %v", ErrBadTag, err)
+		}
+		if ft.min == "" {
+			lo = hi - 2*span
+		}
+	}
+	if math.IsInf(lo, 0) || math.IsInf(hi, 0) || math.IsNaN(lo) || math.IsNaN(hi) {
+		return 0, fmt.Errorf("%w: infinite or NaN bound", ErrBadTag)
+	}
+	if lo > hi {
+		return 0, fmt.Errorf("%w: min > max", ErrBadTag)
+	}
+	// this interpolation does not overflow, unlike lo + u*(hi-lo), but may round out of the range.
+	u := f.g.Float64()
+	x := lo*(1-u) + hi*u
+	if x < lo {
+		return lo, nil
+	}
+	if x > hi {
+		return hi, nil
+	}
+	return x, nil
+}
+
+// fillTime fills the time.Time `v` within the range of the tag, by default 1970 to 2100.
+func (f *filler) fillTime(v reflect.Value, ft *fillTag) error {
+	lo, hi := time.Unix(0, 0).UTC(), time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)
+	var err error
+	if ft.min != "" {
+		if lo, err = time.Parse(time.RFC3339, ft.min); err != nil {
+			return fmt.Errorf("%w: %v", ErrBadTag, err)
+		}
+	}
+	if ft.max != "" {
+		if hi, err = time.Parse(time.RFC3339, ft.max); err != nil {
+			return fmt.Errorf("%w: %v", ErrBadTag, err)
+		}
+	}
+	if hi.Before(lo) {
+		return fmt.Errorf("%w: min > max", ErrBadTag)
+	}
+	v.Set(reflect.ValueOf(f.g.timeBetween(lo, hi)))
+	return nil
+}
+
+// int64Between returns a random integer in the range `lo` to `hi` included.
+func (g *Generator) int64Between(lo int64, hi int64) int64 {
+	if hi <= lo {
+		return lo
+	}
+	span := uint64(hi) - uint64(lo)
+	if span == math.MaxUint64 {
+		return int64(g.Uint64())
+	}
+	return lo + int64(g.Uint64N(span+1))
+}
@@ -0,0 +1,140 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"math"
+	"net/mail"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+type fillAddress struct {
+	Street string `test:"alpha,len=12"`
+	Zip    string `test:"digits,len=5"`
+}
+
+type fillRequest struct {
+	ID       string `test:"id"`
+	Email    string `test:"email"`
+	Age      int    `test:"min=1,max=99"`
+	Score    float64
+	Small    uint8    `test:"min=10,max=20"`
+	Code     string   `test:"pattern=^[A-Z]{2},[0-9]{3}$"`
+	Tags     []string `test:"caps,len=3"`
+	Labels   map[string]int
+	Payload  []byte `test:"len=8"`
+	Address  *fillAddress
+	Previous []fillAddress
+	Created  time.Time `test:"min=2020-01-01T00:00:00Z,max=2021-01-01T00:00:00Z"`
+	Skipped  string    `test:"-"`
+	Any      interface{}
+	private  string
+}
+
+type fillNode struct {
+	Value int
+	Next  *fillNode
+	Kids  []fillNode
+}
+
+func Test_Fill(t *testing.T) {
+	require, assert := Describe(t)
+
+	var r fillRequest
+	require.NoError(Gen(t).Fill(&r))
+	assert.Len(r.ID, 16)
+	_, err := mail.ParseAddress(r.Email)
+	assert.NoError(err)
+	assert.True(r.Age >= 1 && r.Age <= 99)
+	assert.True(r.Small >= 10 && r.Small <= 20)
+	assert.Regexp(regexp.MustCompile(`^[A-Z]{2},[0-9]{3}$`), r.Code)
+	assert.Len(r.Tags, 3)
+	for _, tag := range r.Tags {
+		assert.Equal(strings.ToUpper(tag), tag)
+	}
+	assert.NotEmpty(r.Labels)
+	assert.Len(r.Payload, 8)
+	require.NotNil(r.Address)
+	assert.Len(r.Address.Street, 12)
+	assert.Len(r.Address.Zip, 5)
+	assert.NotEmpty(r.Previous)
+	assert.Equal(2020, r.Created.Year())
+	assert.Empty(r.Skipped)
+	assert.Nil(r.Any)
+	assert.Empty(r.private)
+
+	var empty struct {
+		B []byte `test:"len=0"`
+		S string `test:"len=0"`
+	}
+	require.NoError(Gen(t).Fill(&empty))
+	assert.NotNil(empty.B)
+	assert.Empty(empty.B)
+	assert.Empty(empty.S)
+}
+
+func Test_Fill_Float(t *testing.T) {
+	require, assert := Describe(t)
+
+	g := Gen(t)
+	for i := 0; i < loops; i++ {
+		var v struct {
+			Above float64 `test:"min=2e6"`
+			Below float32 `test:"max=-5e6"`
+			Huge  float64 `test:"min=-1.7e308,max=1.7e308"`
+		}
+		require.NoError(g.Fill(&v))
+		assert.True(v.Above >= 2e6 && v.Above <= 4e6, v.Above)
+		assert.True(v.Below <= -5e6 && v.Below >= -7e6, v.Below)
+		assert.False(math.IsInf(v.Huge, 0) || math.IsNaN(v.Huge), v.Huge)
+	}
+}
+
+func Test_Fill_Recursive(t *testing.T) {
+	require, assert := Describe(t)
+
+	var n fillNode
+	require.NoError(Gen(t).Fill(&n, WithMaxRecursion(3)))
+	depth := 0
+	for p := &n; p != nil; p = p.Next {
+		depth++
+	}
+	assert.Equal(3, depth)
+
+	var n2 fillNode
+	require.NoError(Fill(&n2, WithMaxDepth(1)))
+	assert.Nil(n2.Next)
+	assert.Nil(n2.Kids)
+}
+
+func Test_Fill_Errors(t *testing.T) {
+	_, assert := Describe(t)
+
+	var r fillRequest
+	assert.ErrorIs(Fill(r), ErrNotPointer)
+	assert.ErrorIs(Fill((*fillRequest)(nil)), ErrNotPointer)
+	var bad struct {
+		A string `test:"foo"`
+	}
+	assert.ErrorIs(Fill(&bad), ErrBadTag)
+	var bad2 struct {
+		A int8 `test:"min=1000"`
+	}
+	assert.ErrorIs(Fill(&bad2), ErrBadTag)
+	var bad3 struct {
+		X float64 `test:"min=2,max=1"`
+	}
+	assert.ErrorIs(Fill(&bad3), ErrBadTag)
+	var bad4 struct {
+		X float64 `test:"max=inf"`
+	}
+	assert.ErrorIs(Fill(&bad4), ErrBadTag)
+	var s []int
+	assert.NoError(Fill(&s))
+	assert.NotEmpty(s)
+}
@@ -0,0 +1,128 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+// fuzzVersion is the first line of a seed corpus file of the Go fuzzing.
+const fuzzVersion = "go test fuzz v1"
+
+// ErrFuzzType occurs when a value cannot be a fuzzing argument.
+var ErrFuzzType = errors.New("unsupported fuzzing type")
+
+// WriteFuzzCorpus writes `n` seed corpus files in the directory testdata/fuzz/`fuzzName`, where
+// `go test` looks for the seeds of the fuzz test `fuzzName`.  Each file holds one value of each
+// Arbitrary of `arbs`, in the encoding "go test fuzz v1".  The size of the arbitraries grows from
+// 0 to 100.  The types of the values must be those accepted by testing.F: []byte, string, bool,
+// and the integer and float types.  It returns the paths of the written files.
+func WriteFuzzCorpus(fuzzName string, n int, arbs ...Arbitrary) ([]string, error) {
+	return Default().WriteFuzzCorpus(fuzzName, n, arbs...)
+}
+
+// WriteFuzzCorpus writes `n` seed corpus files for the fuzz test `fuzzName`.  See WriteFuzzCorpus.
+func (g *Generator) WriteFuzzCorpus(fuzzName string, n int, arbs ...Arbitrary) ([]string, error) {
+	if fuzzName == "" || strings.ContainsAny(fuzzName, `/\`) || fuzzName == "." || fuzzName == ".." {
+		return nil, fmt.Errorf("invalid fuzz test name %q", fuzzName)
+	}
+	dir := filepath.Join(fixtureDir, "fuzz", fuzzName)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	var names []string
+	for i := 0; i < n; i++ {
+		data, err := MarshalFuzzValues(g.fuzzValues(i, n, arbs)...)
+		if err != nil {
+			return names, err
+		}
+		// like go test, the name of the file is derived from its content.
+		name := filepath.Join(dir, fmt.Sprintf("%x", sha256.Sum256(data))[:16])
+		if err = os.WriteFile(name, data, 0644); err != nil {
+			return names, err
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// AddFuzzSeeds adds `n` seeds to the fuzz test `f`.  Each seed holds one value of each Arbitrary of
+// `arbs`, drawn from the generator of the fuzz test (see Gen).  The types of the values must match
+// the arguments of the fuzz target.
+func AddFuzzSeeds(f *testing.F, n int, arbs ...Arbitrary) {
+	g := Gen(f)
+	for i := 0; i < n; i++ {
+		values := g.fuzzValues(i, n, arbs)
+		if _, err := MarshalFuzzValues(values...); err != nil {
+			f.Fatal(err)
+		}
+		f.Add(values...)
+	}
+}
+
+// fuzzValues returns the values of the `i`-th of `n` seeds.
+func (g *Generator) fuzzValues(i int, n int, arbs []Arbitrary) []interface{} {
+	const maxSize = 100
+	size := maxSize
+	if n > 1 {
+		size = maxSize * i / (n - 1)
+	}
+	values := make([]interface{}, len(arbs))
+	for j, a := range arbs {
+		values[j] = a.Generate(g, size)
+	}
+	return values
+}
+
+// MarshalFuzzValues returns the seed corpus file holding `values` in the encoding "go test fuzz v1".
+func MarshalFuzzValues(values ...interface{}) ([]byte, error) {
+	if len(values) == 0 {
+		return nil, fmt.Errorf("%w: no value", ErrFuzzType)
+	}
+	b := bytes.NewBufferString(fuzzVersion + "\n")
+	for _, v := range values {
+		switch x := v.(type) {
+		case int, int8, int16, int64, uint, uint16, uint32, uint64, bool:
+			fmt.Fprintf(b, "%T(%v)\n", x, x)
+		case float32:
+			if math.IsNaN(float64(x)) && math.Float32bits(x) != math.Float32bits(float32(math.NaN())) {
+				fmt.Fprintf(b, "math.Float32frombits(0x%x)\n", math.Float32bits(x))
+			} else {
+				fmt.Fprintf(b, "float32(%v)\n", x)
+			}
+		case float64:
+			if math.IsNaN(x) && math.Float64bits(x) != math.Float64bits(math.NaN()) {
+				fmt.Fprintf(b, "math.Float64frombits(0x%x)\n", math.Float64bits(x))
+			} else {
+				fmt.Fprintf(b, "float64(%v)\n", x)
+			}
+		case string:
+			fmt.Fprintf(b, "string(%q)\n", x)
+		case rune:
+			// the invalid runes have no quoted form.
+			if utf8.ValidRune(x) {
+				fmt.Fprintf(b, "rune(%q)\n", x)
+			} else {
+				fmt.Fprintf(b, "int32(%v)\n", x)
+			}
+		case byte:
+			fmt.Fprintf(b, "byte(%q)\n", x)
+		case []byte:
+			fmt.Fprintf(b, "[]byte(%q)\n", x)
+		default:
+			return nil, fmt.Errorf("%w: %T", ErrFuzzType, v)
+		}
+	}
+	return b.Bytes(), nil
+}
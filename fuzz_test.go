@@ -0,0 +1,77 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func Test_WriteFuzzCorpus(t *testing.T) {
+	require, assert := Describe(t)
+
+	name := "Fuzz_" + RandomName(8)
+	defer func() { _ = os.RemoveAll(filepath.Join("testdata", "fuzz", name)) }()
+	files, err := Gen(t).WriteFuzzCorpus(name, 10, ArbBytes(), ArbString(MultiByte), ArbInt(-5, 5))
+	require.NoError(err)
+	assert.Len(files, 10)
+	for _, f := range files {
+		assert.Equal(filepath.Join("testdata", "fuzz", name), filepath.Dir(f))
+		data, err := os.ReadFile(f)
+		require.NoError(err)
+		lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+		require.Len(lines, 4)
+		assert.Equal("go test fuzz v1", lines[0])
+		assert.True(strings.HasPrefix(lines[1], "[]byte(\""))
+		assert.True(strings.HasPrefix(lines[2], "string(\""))
+		assert.True(strings.HasPrefix(lines[3], "int("))
+	}
+
+	_, err = WriteFuzzCorpus("../escape", 1, ArbBool())
+	assert.Error(err)
+	_, err = WriteFuzzCorpus(name, 1, ArbOf(struct{ A int }{}))
+	assert.ErrorIs(err, ErrFuzzType)
+}
+
+func Test_MarshalFuzzValues(t *testing.T) {
+	require, assert := Describe(t)
+
+	data, err := MarshalFuzzValues([]byte("a\x00"), "é\n", 42, int8(-1), uint64(7), true, byte('x'), 'é',
+		rune(-1), 1.5, float32(math.Inf(-1)), math.NaN(), math.Float64frombits(0x7ff8000000000002))
+	require.NoError(err)
+	assert.Equal(`go test fuzz v1
+[]byte("a\x00")
+string("é\n")
+int(42)
+int8(-1)
+uint64(7)
+bool(true)
+byte('x')
+rune('é')
+int32(-1)
+float64(1.5)
+float32(-Inf)
+float64(NaN)
+math.Float64frombits(0x7ff8000000000002)
+`, string(data))
+
+	_, err = MarshalFuzzValues()
+	assert.ErrorIs(err, ErrFuzzType)
+	_, err = MarshalFuzzValues([]int{1})
+	assert.ErrorIs(err, ErrFuzzType)
+}
+
+func Fuzz_SwapCase(f *testing.F) {
+	AddFuzzSeeds(f, 20, ArbString(All))
+	f.Fuzz(func(t *testing.T, s string) {
+		if utf8.RuneCountInString(SwapCase(s)) != utf8.RuneCountInString(s) {
+			t.Errorf("SwapCase(%q) changed the number of characters", s)
+		}
+	})
+}
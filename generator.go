@@ -0,0 +1,195 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"flag"
+	"fmt"
+	"hash/fnv"
+	"math/rand/v2"
+	"os"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+const (
+	// SeedEnv is the environment variable that sets the master seed when the flag -randseed is not used.
+	SeedEnv = "RANDSEED"
+	// pcgStream is the second PCG seed.  It is constant so that a single uint64 defines a stream.
+	pcgStream = 0x9e3779b97f4a7c15
+)
+
+var (
+	seedFlag seedValue
+
+	// masterSeed is random until fixed by the first call of MasterSeed after the parsing of the flags.
+	masterSeed  = rand.Uint64()
+	masterFixed bool
+	masterMu    sync.Mutex
+
+	defaultOnce sync.Once
+	defaultGen  *Generator
+
+	// perTest holds the generator of each running test.
+	perTest   = map[testing.TB]*Generator{}
+	perTestMu sync.Mutex
+)
+
+func init() {
+	flag.Var(&seedFlag, "randseed", "master seed of the random generators of package test (random if unset)")
+}
+
+// seedValue is the value of the flag -randseed.  Any uint64, including 0, is a valid seed, thus set
+// tells whether the flag is used.
+type seedValue struct {
+	seed uint64
+	set  bool
+}
+
+// String implements the flag.Value interface.
+func (sv *seedValue) String() string {
+	if sv == nil || !sv.set {
+		return ""
+	}
+	return strconv.FormatUint(sv.seed, 10)
+}
+
+// Set implements the flag.Value interface.
+func (sv *seedValue) Set(s string) error {
+	v, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return err
+	}
+	sv.seed, sv.set = v, true
+	return nil
+}
+
+// Generator is a seedable source of random data.  It offers the same methods as the package-level
+// Random* functions.  Two Generators created with the same seed produce the same data.
+// A Generator is safe for concurrent use, but concurrent callers share its stream.
+//
+// CAUTION: the randomness is not cryptographically secure.
+type Generator struct {
+	*rand.Rand
+	seed uint64
+}
+
+// lockedSource is a PCG source protected by a mutex.
+type lockedSource struct {
+	mu  sync.Mutex
+	src *rand.PCG
+}
+
+// Uint64 implements the rand.Source interface.
+func (ls *lockedSource) Uint64() uint64 {
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+	return ls.src.Uint64()
+}
+
+// NewGenerator creates a Generator whose stream is fully defined by `seed`.
+func NewGenerator(seed uint64) *Generator {
+	return &Generator{
+		Rand: rand.New(&lockedSource{src: rand.NewPCG(seed, pcgStream)}),
+		seed: seed,
+	}
+}
+
+// Seed returns the seed that created the generator.
+func (g *Generator) Seed() uint64 {
+	return g.seed
+}
+
+// Read fills `p` with random bytes.  It implements the io.Reader interface and never fails.
+func (g *Generator) Read(p []byte) (int, error) {
+	var v uint64
+	for i := range p {
+		if i%8 == 0 {
+			v = g.Uint64()
+		}
+		p[i] = byte(v)
+		v >>= 8
+	}
+	return len(p), nil
+}
+
+// Default returns the package generator used by the package-level Random* functions.
+// It is seeded with MasterSeed.  Its stream is shared by all the tests, thus the data drawn from it
+// depend on the tests that ran before and are not replayed by the seed; use Gen to replay them.
+func Default() *Generator {
+	defaultOnce.Do(func() {
+		defaultGen = NewGenerator(MasterSeed())
+	})
+	return defaultGen
+}
+
+// MasterSeed returns the seed from which all the generators of the package derive.  It is the
+// value of the flag -randseed if set, else the value of the environment variable RANDSEED if set,
+// else a random value.  It is fixed at the first call after the parsing of the flags; a call before,
+// for instance from TestMain, does not prevent a later -randseed from applying.
+func MasterSeed() uint64 {
+	masterMu.Lock()
+	defer masterMu.Unlock()
+	if masterFixed {
+		return masterSeed
+	}
+	seed := lookupSeed(masterSeed)
+	if flag.Parsed() {
+		masterSeed, masterFixed = seed, true
+	}
+	return seed
+}
+
+// lookupSeed returns the seed of the flag -randseed if set, else of the environment variable
+// RANDSEED if set, else `fallback`.
+func lookupSeed(fallback uint64) uint64 {
+	if seedFlag.set {
+		return seedFlag.seed
+	}
+	if s, ok := os.LookupEnv(SeedEnv); ok {
+		if v, err := strconv.ParseUint(s, 10, 64); err == nil {
+			return v
+		}
+	}
+	return fallback
+}
+
+// Gen returns the generator dedicated to the test `t`.  Its seed derives from MasterSeed and
+// the name of the test, thus the stream of a test does not depend on the other tests, even
+// when running in parallel.  If the test fails, the seed is logged with the command to replay it.
+// Only the data drawn from the generators returned by Gen are replayed, not those of the
+// package-level functions, which draw from Default.
+func Gen(t testing.TB) *Generator {
+	perTestMu.Lock()
+	defer perTestMu.Unlock()
+	if g, ok := perTest[t]; ok {
+		return g
+	}
+	g := NewGenerator(testSeed(MasterSeed(), t.Name()))
+	perTest[t] = g
+	t.Cleanup(func() {
+		if t.Failed() {
+			t.Log(replayMessage(t.Name()))
+		}
+		perTestMu.Lock()
+		delete(perTest, t)
+		perTestMu.Unlock()
+	})
+	return g
+}
+
+// testSeed derives the seed of the test `name` from `master`.
+func testSeed(master uint64, name string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(name))
+	return rand.NewPCG(master, h.Sum64()).Uint64()
+}
+
+// replayMessage returns the message explaining how to replay the test `name`.
+func replayMessage(name string) string {
+	return fmt.Sprintf("random seed %d: replay with go test -run '^%s$' -randseed=%d "+
+		"(replays the data of Gen(t), not those of the package-level functions)", MasterSeed(), name, MasterSeed())
+}
@@ -0,0 +1,68 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"strings"
+	"testing"
+)
+
+func Test_NewGenerator(t *testing.T) {
+	_, assert := Describe(t)
+
+	seed := Gen(t).Uint64()
+	g1 := NewGenerator(seed)
+	g2 := NewGenerator(seed)
+	assert.Equal(seed, g1.Seed())
+	assert.Equal(g1.RandomSlice(100), g2.RandomSlice(100))
+	assert.Equal(g1.RandomString(0), g2.RandomString(0))
+	assert.Equal(g1.SwapCase("abcdefghij"), g2.SwapCase("abcdefghij"))
+	assert.NotEqual(g1.RandomID(), NewGenerator(seed+1).RandomID())
+}
+
+func Test_Gen(t *testing.T) {
+	_, assert := Describe(t)
+
+	g := Gen(t)
+	assert.Same(g, Gen(t))
+	assert.Equal(testSeed(MasterSeed(), t.Name()), g.Seed())
+	t.Run("sub", func(t *testing.T) {
+		assert.NotEqual(g.Seed(), Gen(t).Seed())
+	})
+}
+
+func Test_replayMessage(t *testing.T) {
+	_, assert := Describe(t)
+
+	m := replayMessage("Test_foo")
+	assert.True(strings.Contains(m, "-run '^Test_foo$'"))
+	assert.True(strings.Contains(m, "-randseed="))
+	assert.True(strings.Contains(m, "Gen(t)"))
+}
+
+func Test_seedValue(t *testing.T) {
+	require, assert := Describe(t)
+
+	var sv seedValue
+	assert.Equal("", sv.String())
+	require.NoError(sv.Set("0"))
+	assert.True(sv.set)
+	assert.Equal("0", sv.String())
+	assert.Error(sv.Set("-1"))
+}
+
+func Test_lookupSeed(t *testing.T) {
+	_, assert := Describe(t)
+
+	saved := seedFlag
+	defer func() { seedFlag = saved }()
+	seedFlag = seedValue{}
+	t.Setenv(SeedEnv, "7")
+	assert.Equal(uint64(7), lookupSeed(3))
+	t.Setenv(SeedEnv, "bad")
+	assert.Equal(uint64(3), lookupSeed(3))
+	seedFlag = seedValue{seed: 0, set: true}
+	assert.Equal(uint64(0), lookupSeed(3))
+}
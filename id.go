@@ -0,0 +1,246 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"math"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	// crockford is the base32 alphabet of the ULIDs.
+	crockford = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+	// base62 is the alphabet of the KSUIDs.
+	base62 = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+	// ksuidEpoch is the origin, in Unix seconds, of the KSUID timestamps.
+	ksuidEpoch = 1400000000
+	// uniqueTries is the number of attempts to draw an ID not yet used in the test.
+	uniqueTries = 1000
+)
+
+var (
+	// perTestIDs holds the ID registry of each running test.
+	perTestIDs   = map[testing.TB]*IDRegistry{}
+	perTestIDsMu sync.Mutex
+)
+
+// Clock returns the current time.  The sortable IDs read their timestamp from a Clock.
+type Clock func() time.Time
+
+// NewStepClock returns a Clock which first returns `start` and then advances by `step` at each
+// call.  It makes the order of sortable IDs deterministic.  It is safe for concurrent use.
+func NewStepClock(start time.Time, step time.Duration) Clock {
+	var mu sync.Mutex
+	next := start
+	return func() time.Time {
+		mu.Lock()
+		defer mu.Unlock()
+		t := next
+		next = next.Add(step)
+		return t
+	}
+}
+
+// IDOption allows to parameterize the ID functions such as RandomID or RandomULID.
+type IDOption func(opts *idOptions)
+
+type idOptions struct {
+	length   int
+	alphabet *Charset
+	clock    Clock
+}
+
+// WithIDLength sets the number of characters of RandomID.  The default is 16.
+func WithIDLength(length int) IDOption {
+	return func(ido *idOptions) {
+		ido.length = length
+	}
+}
+
+// WithIDAlphabet sets the alphabet of RandomID.  The default is the alphanumerical characters.
+func WithIDAlphabet(c *Charset) IDOption {
+	return func(ido *idOptions) {
+		ido.alphabet = c
+	}
+}
+
+// WithClock sets the clock of the sortable IDs: RandomUUIDv7, RandomULID and RandomKSUID.  The
+// default is time.Now.
+func WithClock(c Clock) IDOption {
+	return func(ido *idOptions) {
+		ido.clock = c
+	}
+}
+
+func collectIDOptions(options ...IDOption) *idOptions {
+	opts := &idOptions{length: 16, clock: time.Now}
+	for _, option := range options {
+		option(opts)
+	}
+	return opts
+}
+
+// RandomUUIDv4 returns a random RFC 9562 UUID of version 4 in its canonical form, for instance
+// "f47ac10b-58cc-4372-a567-0e02b2c3d479".
+func RandomUUIDv4() string {
+	return Default().RandomUUIDv4()
+}
+
+// RandomUUIDv4 returns a random UUID of version 4.  See RandomUUIDv4.
+func (g *Generator) RandomUUIDv4() string {
+	var u [16]byte
+	_, _ = g.Read(u[:])
+	return formatUUID(u, 4)
+}
+
+// RandomUUIDv7 returns a random RFC 9562 UUID of version 7 in its canonical form.  It starts with
+// the Unix time in milliseconds of the clock, thus the UUIDs of different milliseconds sort by
+// time.  See WithClock.
+func RandomUUIDv7(opts ...IDOption) string {
+	return Default().RandomUUIDv7(opts...)
+}
+
+// RandomUUIDv7 returns a random UUID of version 7.  See RandomUUIDv7.
+func (g *Generator) RandomUUIDv7(opts ...IDOption) string {
+	ido := collectIDOptions(opts...)
+	var u [16]byte
+	_, _ = g.Read(u[6:])
+	putMillis(u[:6], ido.clock())
+	return formatUUID(u, 7)
+}
+
+// RandomULID returns a random ULID: 26 characters of Crockford's base32 encoding a 48-bit Unix
+// time in milliseconds followed by 80 random bits.  The ULIDs of different milliseconds sort by
+// time.  See WithClock.
+func RandomULID(opts ...IDOption) string {
+	return Default().RandomULID(opts...)
+}
+
+// RandomULID returns a random ULID.  See RandomULID.
+func (g *Generator) RandomULID(opts ...IDOption) string {
+	ido := collectIDOptions(opts...)
+	var u [16]byte
+	_, _ = g.Read(u[6:])
+	putMillis(u[:6], ido.clock())
+	hi, lo := binary.BigEndian.Uint64(u[:8]), binary.BigEndian.Uint64(u[8:])
+	var b [26]byte
+	for i := len(b) - 1; i >= 0; i-- {
+		b[i] = crockford[lo&0x1f]
+		lo = lo>>5 | hi<<59
+		hi >>= 5
+	}
+	return string(b[:])
+}
+
+// RandomKSUID returns a random KSUID: 27 characters of base62 encoding a 32-bit time in seconds
+// since 2014-05-13 followed by 128 random bits.  The KSUIDs of different seconds sort by time.  A
+// time before 2014-05-13 is clamped to it, and a time after 2150 to the last second of the range.
+// See WithClock.
+func RandomKSUID(opts ...IDOption) string {
+	return Default().RandomKSUID(opts...)
+}
+
+// RandomKSUID returns a random KSUID.  See RandomKSUID.
+func (g *Generator) RandomKSUID(opts ...IDOption) string {
+	const size = 27
+	ido := collectIDOptions(opts...)
+	var u [20]byte
+	secs := ido.clock().Unix() - ksuidEpoch
+	switch {
+	case secs < 0:
+		secs = 0
+	case secs > math.MaxUint32:
+		secs = math.MaxUint32
+	}
+	binary.BigEndian.PutUint32(u[:4], uint32(secs))
+	_, _ = g.Read(u[4:])
+	n, base, m := new(big.Int).SetBytes(u[:]), big.NewInt(int64(len(base62))), new(big.Int)
+	b := make([]byte, size)
+	for i := size - 1; i >= 0; i-- {
+		n.DivMod(n, base, m)
+		b[i] = base62[m.Int64()]
+	}
+	return string(b)
+}
+
+// putMillis writes to the 6 bytes of `p` the Unix time of `t` in milliseconds.
+func putMillis(p []byte, t time.Time) {
+	ms := uint64(t.UnixMilli())
+	for i := 5; i >= 0; i-- {
+		p[i] = byte(ms)
+		ms >>= 8
+	}
+}
+
+// formatUUID sets the version and the variant of `u` and returns its canonical form.
+func formatUUID(u [16]byte, version byte) string {
+	u[6] = u[6]&0x0f | version<<4
+	u[8] = u[8]&0x3f | 0x80
+	s := hex.EncodeToString(u[:])
+	return s[:8] + "-" + s[8:12] + "-" + s[12:16] + "-" + s[16:20] + "-" + s[20:]
+}
+
+// IDRegistry guarantees the uniqueness of the IDs within a test.  It is safe for concurrent use.
+type IDRegistry struct {
+	t    testing.TB
+	g    *Generator
+	mu   sync.Mutex
+	used map[string]bool
+}
+
+// UniqueIDs returns the ID registry of the test `t`.  The IDs drawn through it are never repeated
+// within the test.  The registry uses the generator of the test, see Gen, and is dropped at the end
+// of the test.
+func UniqueIDs(t testing.TB) *IDRegistry {
+	perTestIDsMu.Lock()
+	defer perTestIDsMu.Unlock()
+	if r, ok := perTestIDs[t]; ok {
+		return r
+	}
+	r := &IDRegistry{t: t, g: Gen(t), used: map[string]bool{}}
+	perTestIDs[t] = r
+	t.Cleanup(func() {
+		perTestIDsMu.Lock()
+		delete(perTestIDs, t)
+		perTestIDsMu.Unlock()
+	})
+	return r
+}
+
+// Unique returns an ID drawn by `draw` and not yet used in the test.  The test fails if `draw`
+// returns only used IDs, for instance if its alphabet and length allow too few IDs.
+func (r *IDRegistry) Unique(draw func(g *Generator) string) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := 0; i < uniqueTries; i++ {
+		if id := draw(r.g); !r.used[id] {
+			r.used[id] = true
+			return id
+		}
+	}
+	r.t.Fatalf("no unique ID found after %d attempts", uniqueTries)
+	return ""
+}
+
+// Add records `id` as used.  It returns false if `id` was already used in the test.
+func (r *IDRegistry) Add(id string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.used[id] {
+		return false
+	}
+	r.used[id] = true
+	return true
+}
+
+// RandomID returns a random ID not yet used in the test.  See RandomID.
+func (r *IDRegistry) RandomID(opts ...IDOption) string {
+	return r.Unique(func(g *Generator) string { return g.RandomID(opts...) })
+}
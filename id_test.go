@@ -0,0 +1,86 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_RandomID_Options(t *testing.T) {
+	_, assert := Describe(t)
+
+	g := Gen(t)
+	assert.Len(g.RandomID(), 16)
+	id := g.RandomID(WithIDLength(40), WithIDAlphabet(NewCharset("01")))
+	assert.Regexp(`^[01]{40}$`, id)
+}
+
+func Test_RandomUUID(t *testing.T) {
+	_, assert := Describe(t)
+
+	g := Gen(t)
+	v4 := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+	v7 := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-7[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+	for i := 0; i < loops; i++ {
+		assert.Regexp(v4, g.RandomUUIDv4())
+		assert.Regexp(v7, g.RandomUUIDv7())
+	}
+	u := g.RandomUUIDv7(WithClock(func() time.Time { return time.UnixMilli(0x0123456789ab) }))
+	assert.True(strings.HasPrefix(u, "01234567-89ab-7"), u)
+}
+
+func Test_SortableIDs(t *testing.T) {
+	_, assert := Describe(t)
+
+	g := Gen(t)
+	start := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		draw func(Clock) string
+		step time.Duration
+		re   string
+	}{
+		{func(c Clock) string { return g.RandomUUIDv7(WithClock(c)) }, time.Millisecond, ``},
+		{func(c Clock) string { return g.RandomULID(WithClock(c)) }, time.Millisecond, `^[0-7][0-9A-HJKMNP-TV-Z]{25}$`},
+		{func(c Clock) string { return g.RandomKSUID(WithClock(c)) }, time.Second, `^[0-9A-Za-z]{27}$`},
+	} {
+		clock := NewStepClock(start, tc.step)
+		ids := make([]string, loops)
+		for i := range ids {
+			ids[i] = tc.draw(clock)
+			assert.Regexp(tc.re, ids[i])
+		}
+		assert.True(sort.StringsAreSorted(ids), ids[0])
+	}
+	// the time of a ULID is in its first 10 characters.
+	c := func() time.Time { return time.UnixMilli(1) }
+	assert.True(strings.HasPrefix(g.RandomULID(WithClock(c)), "0000000001"))
+	// the KSUID epoch is the time 0.
+	c = func() time.Time { return time.Unix(ksuidEpoch, 0) }
+	assert.True(strings.HasPrefix(g.RandomKSUID(WithClock(c)), "00000"))
+	// an earlier time is clamped to the epoch.
+	c = func() time.Time { return time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC) }
+	assert.True(strings.HasPrefix(g.RandomKSUID(WithClock(c)), "00000"))
+}
+
+func Test_UniqueIDs(t *testing.T) {
+	require, assert := Describe(t)
+
+	r := UniqueIDs(t)
+	require.Same(r, UniqueIDs(t))
+	seen := map[string]bool{}
+	for i := 0; i < 36; i++ {
+		id := r.RandomID(WithIDLength(1), WithIDAlphabet(NewCharset("abcdefghijklmnopqrstuvwxyz0123456789")))
+		assert.False(seen[id], id)
+		seen[id] = true
+	}
+	assert.False(r.Add("a"))
+	assert.True(r.Add("ab"))
+	id := r.Unique(func(g *Generator) string { return g.RandomUUIDv4() })
+	assert.False(r.Add(id))
+}
@@ -0,0 +1,384 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"image"
+	"image/color"
+	"image/color/palette"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"os"
+)
+
+// ImageFormat is the encoding of an image generated by RandomImage.
+type ImageFormat int
+
+const (
+	// PNG is the PNG encoding.
+	PNG ImageFormat = iota
+	// JPEG is the JPEG encoding.
+	JPEG
+	// GIF is the GIF encoding.
+	GIF
+)
+
+// ImagePattern is the content of a generated image.
+type ImagePattern int
+
+const (
+	// Noise is made of random pixels.
+	Noise ImagePattern = iota
+	// Gradient goes from black to red horizontally, to green vertically and to blue diagonally.
+	Gradient
+	// Checkerboard alternates black and white squares.
+	Checkerboard
+	// Solid has a single color.
+	Solid
+	// TestCard has seven color bars over a gray ramp, a black border and a central cross.
+	TestCard
+)
+
+// ImageColorModel is the color model of a generated image.
+type ImageColorModel int
+
+const (
+	// ColorRGBA is the non-premultiplied RGBA model.
+	ColorRGBA ImageColorModel = iota
+	// ColorGray is the gray model.
+	ColorGray
+	// ColorPaletted is the paletted model.
+	ColorPaletted
+)
+
+// ImageOption allows to parameterize NewTestImage and RandomImage.
+type ImageOption func(opts *imageOptions)
+
+type imageOptions struct {
+	pattern   ImagePattern
+	model     ImageColorModel
+	depth     int
+	color     color.Color
+	truncated bool
+	corrupted bool
+}
+
+// WithPattern sets the pattern of the image.  The default is Noise.
+func WithPattern(p ImagePattern) ImageOption {
+	return func(imo *imageOptions) {
+		imo.pattern = p
+	}
+}
+
+// WithColorModel sets the color model of the image.  The default is ColorRGBA.  JPEG does not
+// support ColorPaletted, whereas GIF always uses a palette.
+func WithColorModel(m ImageColorModel) ImageOption {
+	return func(imo *imageOptions) {
+		imo.model = m
+	}
+}
+
+// WithBitDepth sets the bits per channel, 8 or 16, of the ColorRGBA and ColorGray models, or the bits
+// per pixel, 1 to 8, of the ColorPaletted model.  The default is 8.  Only PNG encodes 16 bits.
+func WithBitDepth(depth int) ImageOption {
+	return func(imo *imageOptions) {
+		imo.depth = depth
+	}
+}
+
+// WithImageColor sets the color of the Solid pattern.  By default, it is random.
+func WithImageColor(c color.Color) ImageOption {
+	return func(imo *imageOptions) {
+		imo.color = c
+	}
+}
+
+// WithTruncation cuts the encoded image at a random offset after its signature.
+func WithTruncation() ImageOption {
+	return func(imo *imageOptions) {
+		imo.truncated = true
+	}
+}
+
+// WithCorruption corrupts the encoded image so that decoding fails: it alters the data of the first
+// PNG IDAT chunk, the class of the first JPEG Huffman table or the LZW code size of the first GIF
+// frame.
+func WithCorruption() ImageOption {
+	return func(imo *imageOptions) {
+		imo.corrupted = true
+	}
+}
+
+func collectImageOptions(options ...ImageOption) *imageOptions {
+	opts := &imageOptions{depth: 8}
+	for _, option := range options {
+		option(opts)
+	}
+	return opts
+}
+
+// NewTestImage returns an image of `width` x `height` pixels.  See the ImageOption functions for
+// its pattern and color model.
+func NewTestImage(width int, height int, opts ...ImageOption) (image.Image, error) {
+	return Default().NewTestImage(width, height, opts...)
+}
+
+// NewTestImage returns an image of `width` x `height` pixels.  See NewTestImage.
+func (g *Generator) NewTestImage(width int, height int, opts ...ImageOption) (image.Image, error) {
+	return g.newTestImage(width, height, collectImageOptions(opts...))
+}
+
+// RandomImage writes to `w` an image of `width` x `height` pixels encoded with `format`.  See the
+// ImageOption functions for its content and for the truncated or corrupted variants.
+func RandomImage(w io.Writer, format ImageFormat, width int, height int, opts ...ImageOption) error {
+	return Default().RandomImage(w, format, width, height, opts...)
+}
+
+// RandomImage writes to `w` an encoded image.  See RandomImage.
+func (g *Generator) RandomImage(w io.Writer, format ImageFormat, width int, height int, opts ...ImageOption) error {
+	imo := collectImageOptions(opts...)
+	img, err := g.newTestImage(width, height, imo)
+	if err != nil {
+		return err
+	}
+	var b bytes.Buffer
+	switch format {
+	case PNG:
+		err = png.Encode(&b, img)
+	case JPEG:
+		if imo.model == ColorPaletted {
+			return fmt.Errorf("JPEG does not support paletted images")
+		}
+		err = jpeg.Encode(&b, img, &jpeg.Options{Quality: g.between(50, 95)})
+	case GIF:
+		err = gif.Encode(&b, img, nil)
+	default:
+		err = fmt.Errorf("unknown image format %d", format)
+	}
+	if err != nil {
+		return err
+	}
+	p := b.Bytes()
+	if imo.corrupted {
+		corruptImage(p, format)
+	}
+	if imo.truncated {
+		// the signatures are 8 bytes long at most.
+		p = p[:g.between(8, len(p)-1)]
+	}
+	_, err = w.Write(p)
+	return err
+}
+
+// RandomImageFile writes to the file `name` an image of `width` x `height` pixels encoded with
+// `format`.  See RandomImage.
+func RandomImageFile(name string, format ImageFormat, width int, height int, opts ...ImageOption) error {
+	return Default().RandomImageFile(name, format, width, height, opts...)
+}
+
+// RandomImageFile writes to the file `name` an encoded image.  See RandomImage.
+func (g *Generator) RandomImageFile(name string, format ImageFormat, width int, height int, opts ...ImageOption) error {
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	if err = g.RandomImage(f, format, width, height, opts...); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// newTestImage returns the image described by `imo`.
+func (g *Generator) newTestImage(width int, height int, imo *imageOptions) (image.Image, error) {
+	if width <= 0 || height <= 0 {
+		return nil, fmt.Errorf("invalid image size %dx%d", width, height)
+	}
+	r := image.Rect(0, 0, width, height)
+	var img interface {
+		image.Image
+		Set(x, y int, c color.Color)
+	}
+	switch {
+	case imo.model == ColorPaletted && imo.depth >= 1 && imo.depth <= 8:
+		img = image.NewPaletted(r, imagePalette(imo.depth))
+	case imo.model == ColorGray && imo.depth == 8:
+		img = image.NewGray(r)
+	case imo.model == ColorGray && imo.depth == 16:
+		img = image.NewGray16(r)
+	case imo.model == ColorRGBA && imo.depth == 8:
+		img = image.NewNRGBA(r)
+	case imo.model == ColorRGBA && imo.depth == 16:
+		img = image.NewNRGBA64(r)
+	default:
+		return nil, fmt.Errorf("unsupported color model %d with bit depth %d", imo.model, imo.depth)
+	}
+	solid := imo.color
+	if solid == nil {
+		solid = g.randomColor()
+	}
+	cell := width
+	if height < width {
+		cell = height
+	}
+	if cell /= 8; cell == 0 {
+		cell = 1
+	}
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			var c color.Color
+			switch imo.pattern {
+			case Gradient:
+				c = color.NRGBA64{R: ramp(x, width), G: ramp(y, height), B: ramp(x+y, width+height-1), A: 0xffff}
+			case Checkerboard:
+				c = color.White
+				if (x/cell+y/cell)%2 == 1 {
+					c = color.Black
+				}
+			case Solid:
+				c = solid
+			case TestCard:
+				c = testCardColor(x, y, width, height)
+			default:
+				c = g.randomColor()
+			}
+			img.Set(x, y, c)
+		}
+	}
+	return img, nil
+}
+
+// randomColor returns a random opaque color.
+func (g *Generator) randomColor() color.Color {
+	u := g.Uint32()
+	return color.NRGBA{R: uint8(u), G: uint8(u >> 8), B: uint8(u >> 16), A: 0xff}
+}
+
+// ramp returns the 16-bit level of the position `i` of a ramp of length `n`.
+func ramp(i int, n int) uint16 {
+	if n <= 1 {
+		return 0
+	}
+	return uint16(i * 0xffff / (n - 1))
+}
+
+// imagePalette returns a palette of 2^`depth` colors spread over the Plan 9 palette, from black to
+// white.
+func imagePalette(depth int) color.Palette {
+	n := 1 << uint(depth)
+	p := make(color.Palette, n)
+	for i := range p {
+		p[i] = palette.Plan9[i*(len(palette.Plan9)-1)/(n-1)]
+	}
+	return p
+}
+
+// testCardBars lists the colors of the bars of the test card.
+var testCardBars = []color.Color{
+	color.White,
+	color.NRGBA{R: 0xff, G: 0xff, A: 0xff},
+	color.NRGBA{G: 0xff, B: 0xff, A: 0xff},
+	color.NRGBA{G: 0xff, A: 0xff},
+	color.NRGBA{R: 0xff, B: 0xff, A: 0xff},
+	color.NRGBA{R: 0xff, A: 0xff},
+	color.NRGBA{B: 0xff, A: 0xff},
+}
+
+// testCardColor returns the color of the pixel (`x`, `y`) of the test card.
+func testCardColor(x int, y int, width int, height int) color.Color {
+	switch {
+	case x == 0 || y == 0 || x == width-1 || y == height-1, x == width/2 || y == height/2:
+		return color.Black
+	case y < height*2/3:
+		return testCardBars[x*len(testCardBars)/width]
+	default:
+		return color.Gray16{Y: ramp(x, width)}
+	}
+}
+
+// corruptImage alters the structure of the image `p` encoded with `format`.  If the structure is
+// not found, it alters the signature.
+func corruptImage(p []byte, format ImageFormat) {
+	var i int
+	switch format {
+	case PNG:
+		i = pngIDAT(p)
+	case JPEG:
+		i = jpegDHT(p)
+	case GIF:
+		i = gifLZW(p)
+	}
+	if i <= 0 || i >= len(p) {
+		p[0] ^= 0xff
+		return
+	}
+	p[i] ^= 0xff
+}
+
+// pngIDAT returns the offset of the first data byte of the first IDAT chunk, whose CRC then fails.
+func pngIDAT(p []byte) int {
+	for i := 8; i+8 <= len(p); {
+		n := int(binary.BigEndian.Uint32(p[i:]))
+		if string(p[i+4:i+8]) == "IDAT" && n > 0 {
+			return i + 8
+		}
+		// the chunk holds its length, type, data and CRC.
+		i += 12 + n
+	}
+	return -1
+}
+
+// jpegDHT returns the offset of the class and destination byte of the first Huffman table.
+// Flipped, the class is invalid.
+func jpegDHT(p []byte) int {
+	for i := 2; i+4 < len(p) && p[i] == 0xff; {
+		if p[i+1] == 0xc4 {
+			return i + 4
+		}
+		i += 2 + int(binary.BigEndian.Uint16(p[i+2:]))
+	}
+	return -1
+}
+
+// gifLZW returns the offset of the LZW minimum code size of the first frame.  Flipped, the size
+// is out of range.
+func gifLZW(p []byte) int {
+	const header = 13
+	if len(p) < header {
+		return -1
+	}
+	i := header
+	if p[10]&0x80 != 0 {
+		i += 3 << (uint(p[10]&0x07) + 1)
+	}
+	for i < len(p) {
+		switch p[i] {
+		case 0x21:
+			// the extension has a label and sub-blocks ending with an empty one.
+			for i += 2; i < len(p) && p[i] != 0; {
+				i += 1 + int(p[i])
+			}
+			i++
+		case 0x2c:
+			if i+10 >= len(p) {
+				return -1
+			}
+			flags := p[i+9]
+			i += 10
+			if flags&0x80 != 0 {
+				i += 3 << (uint(flags&0x07) + 1)
+			}
+			return i
+		default:
+			return -1
+		}
+	}
+	return -1
+}
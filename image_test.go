@@ -0,0 +1,98 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func Test_NewTestImage(t *testing.T) {
+	require, assert := Describe(t)
+
+	g := Gen(t)
+	img, err := g.NewTestImage(16, 8, WithPattern(Solid), WithImageColor(color.NRGBA{R: 0x12, G: 0x34, B: 0x56, A: 0xff}))
+	require.NoError(err)
+	assert.Equal(image.Rect(0, 0, 16, 8), img.Bounds())
+	assert.Equal(color.NRGBA{R: 0x12, G: 0x34, B: 0x56, A: 0xff}, img.At(15, 7))
+
+	img, err = g.NewTestImage(16, 16, WithPattern(Checkerboard), WithColorModel(ColorGray))
+	require.NoError(err)
+	assert.IsType(&image.Gray{}, img)
+	assert.Equal(color.Gray{Y: 0xff}, img.At(0, 0))
+	assert.Equal(color.Gray{}, img.At(2, 0))
+
+	img, err = g.NewTestImage(10, 10, WithPattern(Gradient), WithBitDepth(16))
+	require.NoError(err)
+	assert.IsType(&image.NRGBA64{}, img)
+	assert.Equal(color.NRGBA64{A: 0xffff}, img.At(0, 0))
+	assert.Equal(color.NRGBA64{R: 0xffff, G: 0xffff, B: 0xffff, A: 0xffff}, img.At(9, 9))
+
+	img, err = g.NewTestImage(64, 30, WithPattern(TestCard), WithColorModel(ColorPaletted), WithBitDepth(1))
+	require.NoError(err)
+	require.IsType(&image.Paletted{}, img)
+	assert.Len(img.(*image.Paletted).Palette, 2)
+
+	_, err = g.NewTestImage(0, 10)
+	assert.Error(err)
+	_, err = g.NewTestImage(10, 10, WithBitDepth(12))
+	assert.Error(err)
+}
+
+func Test_RandomImage(t *testing.T) {
+	require, assert := Describe(t)
+
+	g := Gen(t)
+	for _, tc := range []struct {
+		format ImageFormat
+		name   string
+		opts   []ImageOption
+	}{
+		{PNG, "png", []ImageOption{WithBitDepth(16), WithColorModel(ColorGray)}},
+		{PNG, "png", []ImageOption{WithColorModel(ColorPaletted), WithBitDepth(4), WithPattern(TestCard)}},
+		{JPEG, "jpeg", []ImageOption{WithPattern(Gradient)}},
+		{GIF, "gif", nil},
+	} {
+		var b bytes.Buffer
+		width, height := g.between(1, 100), g.between(1, 100)
+		require.NoError(g.RandomImage(&b, tc.format, width, height, tc.opts...))
+		img, name, err := image.Decode(&b)
+		require.NoError(err)
+		assert.Equal(tc.name, name)
+		assert.Equal(image.Rect(0, 0, width, height), img.Bounds())
+
+		b.Reset()
+		require.NoError(g.RandomImage(&b, tc.format, width, height, append(tc.opts, WithTruncation())...))
+		_, _, err = image.Decode(&b)
+		assert.Error(err, tc.name)
+
+		b.Reset()
+		require.NoError(g.RandomImage(&b, tc.format, width, height, append(tc.opts, WithCorruption())...))
+		_, name, err = image.Decode(&b)
+		assert.Error(err, tc.name)
+		assert.Equal(tc.name, name)
+	}
+	assert.Error(g.RandomImage(&bytes.Buffer{}, JPEG, 8, 8, WithColorModel(ColorPaletted)))
+	assert.Error(g.RandomImage(&bytes.Buffer{}, ImageFormat(42), 8, 8))
+}
+
+func Test_RandomImageFile(t *testing.T) {
+	require, assert := Describe(t)
+
+	name := filepath.Join(t.TempDir(), "a.png")
+	require.NoError(RandomImageFile(name, PNG, 20, 10, WithPattern(Noise)))
+	data, err := os.ReadFile(name)
+	require.NoError(err)
+	cfg, err := png.DecodeConfig(bytes.NewReader(data))
+	require.NoError(err)
+	assert.Equal(20, cfg.Width)
+	assert.Equal(10, cfg.Height)
+	assert.Error(RandomImageFile(filepath.Join(t.TempDir(), "no", "a.png"), PNG, 20, 10))
+}
@@ -106,7 +106,7 @@ func (irw *InRAMWriter) WriteAt(p []byte, pos int64) (int, error) {
 	if irw.closed {
 		return 0, ErrClosed
 	}
-	return irw.WriteAt(p, pos)
+	return irw.writeAtBuffer.WriteAt(p, pos)
 }
 
 // A writeAtBuffer provides an in memory buffer supporting the io.WriterAt interface
@@ -0,0 +1,539 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"fmt"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Validity selects whether a generator produces ordinary valid values, valid but unusual values,
+// or invalid values.
+type Validity int
+
+const (
+	// Valid requests ordinary, well-formed values.
+	Valid Validity = iota
+	// EdgeCase requests values that are well-formed but unusual, such as maximal lengths or
+	// rarely used syntaxes.
+	EdgeCase
+	// Invalid requests malformed values that a strict parser should reject.
+	Invalid
+)
+
+const (
+	maxLabel    = 63  // max number of characters of a DNS label.
+	maxHostname = 253 // max number of characters of a DNS name.
+	maxLocal    = 64  // max number of characters of the local part of an email address.
+	maxPort     = 65535
+)
+
+// idnLabels are internationalized labels used to build IDN hosts.
+var idnLabels = []string{"bücher", "münchen", "españa", "café", "пример", "例え", "δοκιμή", "παράδειγμα", "مثال"}
+
+// tlds are top-level domains used to build host names.
+var tlds = []string{"com", "org", "net", "io", "fr", "de", "jp", "example", "test", "info"}
+
+// RandomHostname returns a random DNS host name according to `v`.
+func RandomHostname(v Validity) string {
+	return Default().RandomHostname(v)
+}
+
+// RandomHostname returns a random DNS host name according to `v`.  See RandomHostname.
+func (g *Generator) RandomHostname(v Validity) string {
+	switch v {
+	case EdgeCase:
+		return g.pick(
+			func() string { return g.label(maxLabel) + "." + g.tld() },
+			func() string { return g.longHostname() },
+			func() string {
+				return g.RandomAlphaString(1, Small) + "." + g.RandomAlphaString(1, Small) + "." + g.tld()
+			},
+			func() string { return g.RandomHostname(Valid) + "." },
+			func() string { return "xn--" + punycode(g.pickString(idnLabels)) + "." + g.tld() },
+			func() string { return g.RandomAlphaString(g.IntN(5)+1, Caps) + "." + strings.ToUpper(g.tld()) },
+			func() string { return "localhost" },
+		)
+	case Invalid:
+		return g.pick(
+			func() string { return g.label(maxLabel+1+g.IntN(10)) + "." + g.tld() },
+			func() string { return "-" + g.label(g.IntN(10)+1) + "." + g.tld() },
+			func() string { return g.label(g.IntN(10)+1) + "-." + g.tld() },
+			func() string { return g.label(g.IntN(10)+1) + ".." + g.tld() },
+			func() string { return g.label(g.IntN(5)+1) + "_" + g.label(g.IntN(5)+1) + "." + g.tld() },
+			func() string { return g.label(g.IntN(5)+1) + " " + g.label(g.IntN(5)+1) + "." + g.tld() },
+			func() string { return g.longHostname() + "." + g.label(maxLabel) },
+			func() string { return "." + g.tld() },
+		)
+	default:
+		n := g.IntN(3) + 1
+		labels := make([]string, 0, n+1)
+		for i := 0; i < n; i++ {
+			labels = append(labels, strings.ToLower(g.label(g.IntN(12)+1)))
+		}
+		return strings.Join(append(labels, g.tld()), ".")
+	}
+}
+
+// RandomEmail returns a random email address according to `v`.  The valid and edge case addresses
+// comply with RFC 5322.
+func RandomEmail(v Validity) string {
+	return Default().RandomEmail(v)
+}
+
+// RandomEmail returns a random email address according to `v`.  See RandomEmail.
+func (g *Generator) RandomEmail(v Validity) string {
+	switch v {
+	case EdgeCase:
+		return g.pick(
+			func() string { return `"` + g.RandomAlphaString(g.IntN(10)+1, Alpha) + `"@` + g.RandomHostname(Valid) },
+			func() string { return g.atom(10) + "+" + g.atom(10) + "@" + g.RandomHostname(Valid) },
+			func() string { return g.RandomAlphaString(maxLocal, AlphaNumNoSpace) + "@" + g.RandomHostname(Valid) },
+			func() string { return g.atom(10) + "@[" + g.RandomIPv4(Valid) + "]" },
+			func() string { return g.RandomAlphaString(g.IntN(10)+1, AText) + "@" + g.RandomHostname(Valid) },
+			func() string {
+				return g.RandomAlphaString(1, Small) + "@" + g.RandomAlphaString(1, Small) + "." + g.tld()
+			},
+			func() string { return g.localPart() + "@" + g.label(maxLabel) + "." + g.tld() },
+		)
+	case Invalid:
+		return g.pick(
+			func() string { return g.localPart() + g.RandomHostname(Valid) },
+			func() string { return g.localPart() + "@" + g.localPart() + "@" + g.RandomHostname(Valid) },
+			func() string { return "." + g.localPart() + "@" + g.RandomHostname(Valid) },
+			func() string { return g.atom(5) + ".." + g.atom(5) + "@" + g.RandomHostname(Valid) },
+			func() string { return g.localPart() + ".@" + g.RandomHostname(Valid) },
+			func() string { return "@" + g.RandomHostname(Valid) },
+			func() string { return g.atom(5) + " " + g.atom(5) + "@" + g.RandomHostname(Valid) },
+			func() string { return g.localPart() + "@" },
+			func() string { return g.localPart() + "@" + g.RandomHostname(Valid) + ".." },
+		)
+	default:
+		return g.localPart() + "@" + g.RandomHostname(Valid)
+	}
+}
+
+// RandomURL returns a random URL according to `v`.  The path and the query are percent-encoded.
+// Edge cases include IDN hosts, IPv6 literals and user information.
+func RandomURL(v Validity) string {
+	return Default().RandomURL(v)
+}
+
+// RandomURL returns a random URL according to `v`.  See RandomURL.
+func (g *Generator) RandomURL(v Validity) string {
+	switch v {
+	case EdgeCase:
+		u := g.url(g.idnHost())
+		return g.pick(
+			func() string { return iriString(u) },
+			func() string {
+				u.Host = "[" + g.RandomIPv6(Valid) + "]:" + strconv.Itoa(g.RandomPort(Valid))
+				return iriString(u)
+			},
+			func() string { u.User = url.UserPassword(g.RandomName(0), g.RandomString(0)); return iriString(u) },
+			func() string { u.Host += ":" + strconv.Itoa(g.RandomPort(EdgeCase)); return iriString(u) },
+			func() string { u.Path = "/" + idnLabels[g.IntN(len(idnLabels))]; return iriString(u) },
+			func() string { u.Path, u.RawQuery, u.Fragment = "", "", ""; return iriString(u) },
+			func() string { u.Host = g.RandomIPv4(EdgeCase); return iriString(u) },
+		)
+	case Invalid:
+		u := "http://" + g.RandomHostname(Valid)
+		return g.pick(
+			func() string { return u + "/%z" + g.RandomName(1) },
+			func() string { return "://" + g.RandomHostname(Valid) },
+			func() string { return "http://[" + g.RandomIPv6(Valid) + "/" },
+			func() string { return "http://" + g.RandomHostname(Valid) + ":" + g.RandomName(4) },
+			func() string { return u + "/\x7f" + g.RandomName(4) },
+			func() string { return "http://" + g.RandomName(4) + "%zz.com/" },
+			func() string { return " http://" + g.RandomHostname(Valid) },
+		)
+	default:
+		return g.url(g.RandomHostname(Valid)).String()
+	}
+}
+
+// RandomIPv4 returns a random IPv4 address according to `v`.
+func RandomIPv4(v Validity) string {
+	return Default().RandomIPv4(v)
+}
+
+// RandomIPv4 returns a random IPv4 address according to `v`.  See RandomIPv4.
+func (g *Generator) RandomIPv4(v Validity) string {
+	switch v {
+	case EdgeCase:
+		return g.pick(
+			func() string { return "0.0.0.0" },
+			func() string { return "255.255.255.255" },
+			func() string { return fmt.Sprintf("127.%d.%d.%d", g.IntN(256), g.IntN(256), g.IntN(256)) },
+			func() string { return fmt.Sprintf("10.%d.%d.%d", g.IntN(256), g.IntN(256), g.IntN(256)) },
+			func() string { return fmt.Sprintf("169.254.%d.%d", g.IntN(256), g.IntN(256)) },
+			func() string {
+				return fmt.Sprintf("%d.%d.%d.%d", 224+g.IntN(16), g.IntN(256), g.IntN(256), g.IntN(256))
+			},
+			func() string { return fmt.Sprintf("192.168.%d.255", g.IntN(256)) },
+		)
+	case Invalid:
+		return g.pick(
+			func() string {
+				return fmt.Sprintf("%d.%d.%d.%d", 256+g.IntN(744), g.IntN(256), g.IntN(256), g.IntN(256))
+			},
+			func() string { return fmt.Sprintf("%d.%d.%d", g.IntN(256), g.IntN(256), g.IntN(256)) },
+			func() string {
+				return fmt.Sprintf("%d.%d.%d.%d.%d", g.IntN(256), g.IntN(256), g.IntN(256), g.IntN(256), g.IntN(256))
+			},
+			func() string { return fmt.Sprintf("0%d.%d.%d.%d", g.IntN(100), g.IntN(256), g.IntN(256), g.IntN(256)) },
+			func() string { return g.RandomIPv4(Valid) + "." },
+			func() string {
+				return fmt.Sprintf("%d.%d.%s.%d", g.IntN(256), g.IntN(256), g.RandomName(2), g.IntN(256))
+			},
+			func() string {
+				return fmt.Sprintf("%d.-%d.%d.%d", g.IntN(256), g.IntN(256)+1, g.IntN(256), g.IntN(256))
+			},
+		)
+	default:
+		var b [4]byte
+		_, _ = g.Read(b[:])
+		return netip.AddrFrom4(b).String()
+	}
+}
+
+// RandomIPv6 returns a random IPv6 address according to `v`.  The valid addresses use the
+// canonical RFC 5952 form.
+func RandomIPv6(v Validity) string {
+	return Default().RandomIPv6(v)
+}
+
+// RandomIPv6 returns a random IPv6 address according to `v`.  See RandomIPv6.
+func (g *Generator) RandomIPv6(v Validity) string {
+	switch v {
+	case EdgeCase:
+		return g.pick(
+			func() string { return "::" },
+			func() string { return "::1" },
+			func() string { return "::ffff:" + g.RandomIPv4(Valid) },
+			func() string { return g.expandedIPv6() },
+			func() string { return strings.ToUpper(g.RandomIPv6(Valid)) },
+			func() string { return "fe80::" + g.RandomAlphaString(4, Hex) + "%eth" + strconv.Itoa(g.IntN(10)) },
+			func() string { return "ff02::" + g.RandomAlphaString(g.IntN(4)+1, Hex) },
+			func() string { return "64:ff9b::" + g.RandomIPv4(Valid) },
+		)
+	case Invalid:
+		return g.pick(
+			func() string { return g.RandomAlphaString(4, Hex) + "::" + g.RandomAlphaString(4, Hex) + "::1" },
+			func() string { return g.expandedIPv6() + ":" + g.RandomAlphaString(4, Hex) },
+			func() string { return g.RandomAlphaString(5, Hex) + "::1" },
+			func() string { return g.RandomAlphaString(3, Hex) + "g::1" },
+			func() string { return ":::" + g.RandomAlphaString(4, Hex) },
+			func() string { return "::ffff:" + g.RandomIPv4(Invalid) },
+			func() string { return g.RandomAlphaString(4, Hex) + ":" + g.RandomAlphaString(4, Hex) },
+		)
+	default:
+		var b [16]byte
+		_, _ = g.Read(b[:])
+		return netip.AddrFrom16(b).String()
+	}
+}
+
+// RandomCIDR returns a random IPv4 or IPv6 CIDR block according to `v`.  The valid blocks have
+// no host bits set.
+func RandomCIDR(v Validity) string {
+	return Default().RandomCIDR(v)
+}
+
+// RandomCIDR returns a random IPv4 or IPv6 CIDR block according to `v`.  See RandomCIDR.
+func (g *Generator) RandomCIDR(v Validity) string {
+	switch v {
+	case EdgeCase:
+		return g.pick(
+			func() string { return "0.0.0.0/0" },
+			func() string { return "::/0" },
+			func() string { return g.RandomIPv4(Valid) + "/32" },
+			func() string { return g.RandomIPv6(Valid) + "/128" },
+			func() string { return g.RandomIPv4(Valid) + "/" + strconv.Itoa(g.IntN(24)+1) },
+		)
+	case Invalid:
+		return g.pick(
+			func() string { return g.RandomIPv4(Valid) + "/" + strconv.Itoa(33+g.IntN(100)) },
+			func() string { return g.RandomIPv6(Valid) + "/" + strconv.Itoa(129+g.IntN(100)) },
+			func() string { return g.RandomIPv4(Valid) + "/" },
+			func() string { return g.RandomIPv4(Valid) + "/-" + strconv.Itoa(g.IntN(32)+1) },
+			func() string { return g.RandomIPv4(Invalid) + "/" + strconv.Itoa(g.IntN(33)) },
+			func() string { return g.RandomIPv4(Valid) + "/" + g.RandomName(2) },
+		)
+	default:
+		var p netip.Prefix
+		if g.IntN(2) == 0 {
+			p = netip.PrefixFrom(netip.MustParseAddr(g.RandomIPv4(Valid)), g.IntN(33))
+		} else {
+			p = netip.PrefixFrom(netip.MustParseAddr(g.RandomIPv6(Valid)), g.IntN(129))
+		}
+		return p.Masked().String()
+	}
+}
+
+// RandomPort returns a random TCP or UDP port according to `v`.  The valid ports are in the
+// range 1 to 65535.  The edge cases are the boundaries of the well-known, registered and dynamic ranges.
+func RandomPort(v Validity) int {
+	return Default().RandomPort(v)
+}
+
+// RandomPort returns a random TCP or UDP port according to `v`.  See RandomPort.
+func (g *Generator) RandomPort(v Validity) int {
+	switch v {
+	case EdgeCase:
+		edges := []int{0, 1, 1023, 1024, 49151, 49152, maxPort}
+		return edges[g.IntN(len(edges))]
+	case Invalid:
+		invalids := []int{-1, -g.IntN(maxPort) - 1, maxPort + 1, maxPort + 1 + g.IntN(maxPort)}
+		return invalids[g.IntN(len(invalids))]
+	default:
+		return g.IntN(maxPort) + 1
+	}
+}
+
+// RandomMAC returns a random MAC address according to `v`.  The valid addresses are 48-bit
+// in one of the notations accepted by net.ParseMAC.
+func RandomMAC(v Validity) string {
+	return Default().RandomMAC(v)
+}
+
+// RandomMAC returns a random MAC address according to `v`.  See RandomMAC.
+func (g *Generator) RandomMAC(v Validity) string {
+	switch v {
+	case EdgeCase:
+		return g.pick(
+			func() string { return "ff:ff:ff:ff:ff:ff" },
+			func() string { return "00:00:00:00:00:00" },
+			func() string { return "01:00:5e:" + g.macGroups(3, ":") },
+			func() string { return "02:" + g.macGroups(5, ":") },
+			func() string { return g.macGroups(8, ":") },
+			func() string { return strings.ToUpper(g.macGroups(6, "-")) },
+		)
+	case Invalid:
+		return g.pick(
+			func() string { return g.macGroups(5, ":") },
+			func() string { return g.macGroups(7, ":") },
+			func() string { return g.macGroups(5, ":") + ":z" + g.RandomName(1) },
+			func() string { return g.macGroups(3, ":") + "-" + g.macGroups(3, "-") },
+			func() string { return g.macGroups(5, ":") + ":" + g.RandomAlphaString(3, Hex) },
+			func() string { return g.RandomAlphaString(11, Hex) },
+		)
+	default:
+		switch g.IntN(3) {
+		case 0:
+			return g.macGroups(6, "-")
+		case 1:
+			return strings.Join(splitEvery(g.RandomAlphaString(12, Hex), 4), ".")
+		default:
+			return g.macGroups(6, ":")
+		}
+	}
+}
+
+// pick calls one of the functions `fs` at random and returns its result.
+func (g *Generator) pick(fs ...func() string) string {
+	return fs[g.IntN(len(fs))]()
+}
+
+// label returns a DNS label of `n` characters that starts and ends with an alphanumerical character.
+func (g *Generator) label(n int) string {
+	if n <= 2 {
+		return g.RandomAlphaString(n, AlphaNumNoSpace)
+	}
+	return g.RandomAlphaString(1, AlphaNumNoSpace) + g.RandomAlphaString(n-2, LDH) + g.RandomAlphaString(1, AlphaNumNoSpace)
+}
+
+// tld returns a random top-level domain.
+func (g *Generator) tld() string {
+	return tlds[g.IntN(len(tlds))]
+}
+
+// longHostname returns a valid host name of exactly 253 characters.
+func (g *Generator) longHostname() string {
+	var labels []string
+	size := 0
+	for size+maxLabel+1 < maxHostname {
+		labels = append(labels, g.label(maxLabel))
+		size += maxLabel + 1
+	}
+	return strings.Join(append(labels, g.label(maxHostname-size)), ".")
+}
+
+// idnHost returns a host name with an internationalized label, either in Unicode or as its punycode
+// A-label.
+func (g *Generator) idnHost() string {
+	label := g.pickString(idnLabels)
+	if g.IntN(2) == 0 {
+		label = "xn--" + punycode(label)
+	}
+	return label + "." + g.tld()
+}
+
+// iriString returns `u` as a string whose host is not percent-encoded, so that the Unicode host
+// names stay readable, as in an IRI.
+func iriString(u *url.URL) string {
+	s := u.String()
+	escaped := strings.TrimPrefix((&url.URL{Host: u.Host}).String(), "//")
+	if escaped == u.Host {
+		return s
+	}
+	i := strings.Index(s, "//") + 2
+	if u.User != nil {
+		i += len(u.User.String()) + 1
+	}
+	if !strings.HasPrefix(s[i:], escaped) {
+		return s
+	}
+	return s[:i] + u.Host + s[i+len(escaped):]
+}
+
+// The parameters of punycode, RFC 3492.
+const (
+	punyBase        = 36
+	punyTMin        = 1
+	punyTMax        = 26
+	punySkew        = 38
+	punyDamp        = 700
+	punyInitialBias = 72
+	punyInitialN    = 128
+)
+
+// punycode returns the punycode encoding of the label `s`, without the prefix "xn--".
+func punycode(s string) string {
+	runes := []rune(s)
+	var out []byte
+	for _, r := range runes {
+		if r < punyInitialN {
+			out = append(out, byte(r))
+		}
+	}
+	basic := len(out)
+	if basic > 0 {
+		out = append(out, '-')
+	}
+	n, delta, bias := rune(punyInitialN), 0, punyInitialBias
+	for h := basic; h < len(runes); n++ {
+		m := rune(unicode.MaxRune + 1)
+		for _, r := range runes {
+			if r >= n && r < m {
+				m = r
+			}
+		}
+		delta += int(m-n) * (h + 1)
+		n = m
+		for _, r := range runes {
+			if r < n {
+				delta++
+			}
+			if r != n {
+				continue
+			}
+			q := delta
+			for k := punyBase; ; k += punyBase {
+				t := k - bias
+				if t < punyTMin {
+					t = punyTMin
+				} else if t > punyTMax {
+					t = punyTMax
+				}
+				if q < t {
+					break
+				}
+				out = append(out, punyDigit(t+(q-t)%(punyBase-t)))
+				q = (q - t) / (punyBase - t)
+			}
+			out = append(out, punyDigit(q))
+			bias = punyAdapt(delta, h+1, h == basic)
+			delta = 0
+			h++
+		}
+		delta++
+	}
+	return string(out)
+}
+
+// punyDigit returns the punycode digit of `d`.
+func punyDigit(d int) byte {
+	if d < 26 {
+		return byte('a' + d)
+	}
+	return byte('0' + d - 26)
+}
+
+// punyAdapt returns the new bias of punycode.
+func punyAdapt(delta int, points int, first bool) int {
+	if first {
+		delta /= punyDamp
+	} else {
+		delta /= 2
+	}
+	delta += delta / points
+	k := 0
+	for delta > (punyBase-punyTMin)*punyTMax/2 {
+		delta /= punyBase - punyTMin
+		k += punyBase
+	}
+	return k + (punyBase-punyTMin+1)*delta/(delta+punySkew)
+}
+
+// atom returns an RFC 5322 atom of 1 to `n` characters.
+func (g *Generator) atom(n int) string {
+	return g.RandomAlphaString(g.IntN(n)+1, AText)
+}
+
+// localPart returns a dot-atom local part of an email address.
+func (g *Generator) localPart() string {
+	n := g.IntN(3) + 1
+	atoms := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		atoms = append(atoms, strings.ToLower(g.RandomAlphaString(g.IntN(10)+1, AlphaNumNoSpace)))
+	}
+	return strings.Join(atoms, ".")
+}
+
+// url returns a random http(s) URL for `host` with an encoded path, query and optional fragment.
+func (g *Generator) url(host string) *url.URL {
+	u := &url.URL{Scheme: []string{"http", "https"}[g.IntN(2)], Host: host}
+	for i := g.IntN(4); i > 0; i-- {
+		u = u.JoinPath(g.RandomString(g.IntN(12) + 1))
+	}
+	q := url.Values{}
+	for i := g.IntN(3); i > 0; i-- {
+		q.Add(g.RandomAlphaString(g.IntN(8)+1, Unreserved), g.RandomString(g.IntN(12)+1))
+	}
+	u.RawQuery = q.Encode()
+	if g.IntN(4) == 0 {
+		u.Fragment = g.RandomAlphaString(g.IntN(8)+1, AlphaNum)
+	}
+	return u
+}
+
+// expandedIPv6 returns an IPv6 address with its eight groups of four hexadecimal digits.
+func (g *Generator) expandedIPv6() string {
+	groups := make([]string, 8)
+	for i := range groups {
+		groups[i] = g.RandomAlphaString(4, Hex)
+	}
+	return strings.Join(groups, ":")
+}
+
+// macGroups returns `n` random bytes as hexadecimal pairs separated by `sep`.
+func (g *Generator) macGroups(n int, sep string) string {
+	return strings.Join(splitEvery(g.RandomAlphaString(2*n, Hex), 2), sep)
+}
+
+// splitEvery splits `s` in chunks of `n` bytes.
+func splitEvery(s string, n int) []string {
+	var chunks []string
+	for len(s) > n {
+		chunks = append(chunks, s[:n])
+		s = s[n:]
+	}
+	return append(chunks, s)
+}
@@ -0,0 +1,160 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"net"
+	"net/mail"
+	"net/netip"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+const loops = 200
+
+func Test_RandomHostname(t *testing.T) {
+	_, assert := Describe(t)
+
+	g := Gen(t)
+	for i := 0; i < loops; i++ {
+		h := g.RandomHostname(Valid)
+		assert.True(isHostname(h), h)
+		h = g.RandomHostname(EdgeCase)
+		assert.True(isHostname(strings.TrimSuffix(h, ".")), h)
+		h = g.RandomHostname(Invalid)
+		assert.False(isHostname(h), h)
+	}
+}
+
+func Test_RandomEmail(t *testing.T) {
+	_, assert := Describe(t)
+
+	g := Gen(t)
+	for i := 0; i < loops; i++ {
+		e := g.RandomEmail(Valid)
+		_, err := mail.ParseAddress(e)
+		assert.NoError(err, e)
+		e = g.RandomEmail(EdgeCase)
+		_, err = mail.ParseAddress(e)
+		assert.NoError(err, e)
+		e = g.RandomEmail(Invalid)
+		_, err = mail.ParseAddress(e)
+		assert.Error(err, e)
+	}
+}
+
+func Test_RandomURL(t *testing.T) {
+	_, assert := Describe(t)
+
+	g := Gen(t)
+	for i := 0; i < loops; i++ {
+		u := g.RandomURL(Valid)
+		v, err := url.Parse(u)
+		assert.NoError(err, u)
+		if err == nil {
+			assert.True(isHostname(v.Hostname()), u)
+		}
+		u = g.RandomURL(EdgeCase)
+		v, err = url.Parse(u)
+		assert.NoError(err, u)
+		if err == nil {
+			assert.NotContains(v.Host, "%", u)
+		}
+		u = g.RandomURL(Invalid)
+		_, err = url.Parse(u)
+		assert.Error(err, u)
+	}
+}
+
+func Test_RandomIP(t *testing.T) {
+	_, assert := Describe(t)
+
+	g := Gen(t)
+	for i := 0; i < loops; i++ {
+		for _, v := range []Validity{Valid, EdgeCase} {
+			a, err := netip.ParseAddr(g.RandomIPv4(v))
+			assert.NoError(err)
+			assert.True(a.Is4())
+			a, err = netip.ParseAddr(g.RandomIPv6(v))
+			assert.NoError(err)
+			assert.True(a.Is6())
+			_, _, err = net.ParseCIDR(g.RandomCIDR(v))
+			assert.NoError(err)
+		}
+		s := g.RandomIPv4(Invalid)
+		assert.Nil(net.ParseIP(s), s)
+		s = g.RandomIPv6(Invalid)
+		_, err := netip.ParseAddr(s)
+		assert.Error(err, s)
+		s = g.RandomCIDR(Invalid)
+		_, _, err = net.ParseCIDR(s)
+		assert.Error(err, s)
+	}
+	p := netip.MustParsePrefix(g.RandomCIDR(Valid))
+	assert.Equal(p.Masked(), p)
+}
+
+func Test_RandomPort(t *testing.T) {
+	_, assert := Describe(t)
+
+	g := Gen(t)
+	for i := 0; i < loops; i++ {
+		p := g.RandomPort(Valid)
+		assert.True(p >= 1 && p <= 65535)
+		p = g.RandomPort(EdgeCase)
+		assert.True(p >= 0 && p <= 65535)
+		p = g.RandomPort(Invalid)
+		assert.True(p < 0 || p > 65535)
+	}
+}
+
+func Test_RandomMAC(t *testing.T) {
+	_, assert := Describe(t)
+
+	g := Gen(t)
+	for i := 0; i < loops; i++ {
+		m := g.RandomMAC(Valid)
+		hw, err := net.ParseMAC(m)
+		assert.NoError(err, m)
+		assert.Len(hw, 6)
+		m = g.RandomMAC(EdgeCase)
+		_, err = net.ParseMAC(m)
+		assert.NoError(err, m)
+		m = g.RandomMAC(Invalid)
+		_, err = net.ParseMAC(m)
+		assert.Error(err, m)
+	}
+}
+
+func Test_punycode(t *testing.T) {
+	_, assert := Describe(t)
+
+	// the examples of RFC 3492 and of the IANA test domains.
+	assert.Equal("bcher-kva", punycode("bücher"))
+	assert.Equal("mnchen-3ya", punycode("münchen"))
+	assert.Equal("espaa-rta", punycode("españa"))
+	assert.Equal("r8jz45g", punycode("例え"))
+	assert.Equal("e1afmkfd", punycode("пример"))
+	assert.Equal("egbpdaj6bu4bxfgehfvwxn", punycode("ليهمابتكلموشعربي؟"))
+}
+
+// isHostname returns true if `h` is a valid DNS host name.
+func isHostname(h string) bool {
+	if len(h) == 0 || len(h) > maxHostname {
+		return false
+	}
+	for _, l := range strings.Split(h, ".") {
+		if len(l) == 0 || len(l) > maxLabel || l[0] == '-' || l[len(l)-1] == '-' {
+			return false
+		}
+		for _, c := range l {
+			if !strings.ContainsRune("ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-", c) {
+				return false
+			}
+		}
+	}
+	return true
+}
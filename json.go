@@ -0,0 +1,531 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+var (
+	// ErrUnsatisfiable occurs when no random JSON document could be generated for a schema.
+	ErrUnsatisfiable = errors.New("no document found for the schema")
+)
+
+const (
+	// jsonTries is the number of attempts to generate a document with the expected violations.
+	jsonTries = 64
+	// defaultSpan is the default range of the unbounded numbers, strings and arrays.
+	defaultSpan = 1000
+	// defaultItems is the default maximal number of items of an array.
+	defaultItems = 5
+	// defaultLength is the default maximal number of characters of a string.
+	defaultLength = 16
+)
+
+// jsonTarget is the constraint that a negative document violates.
+type jsonTarget struct {
+	node      *JSONSchema
+	keyword   string
+	arg       string               // the name of the property for the keyword required.
+	ancestors map[*JSONSchema]bool // the schemas containing node.
+	done      bool
+}
+
+// RandomJSON returns a random JSON document valid against `schema`.  The schema is either parsed by
+// ParseJSONSchema or built as a Go value, whose patterns are then compiled on first use.
+func RandomJSON(schema *JSONSchema) ([]byte, error) {
+	return Default().RandomJSON(schema)
+}
+
+// RandomJSON returns a random JSON document valid against `schema`.  See RandomJSON.
+func (g *Generator) RandomJSON(schema *JSONSchema) ([]byte, error) {
+	if err := schema.compile(); err != nil {
+		return nil, err
+	}
+	for i := 0; i < jsonTries; i++ {
+		data, errs, err := g.jsonDocument(schema, nil)
+		if err != nil {
+			return nil, err
+		}
+		if len(errs) == 0 {
+			return data, nil
+		}
+	}
+	return nil, ErrUnsatisfiable
+}
+
+// RandomInvalidJSON returns a random JSON document that violates exactly one constraint of
+// `schema`, and the description of this constraint as "JSON pointer: keyword", for instance
+// "/items/0/age: minimum".  It is meant for negative tests.
+func RandomInvalidJSON(schema *JSONSchema) ([]byte, string, error) {
+	return Default().RandomInvalidJSON(schema)
+}
+
+// RandomInvalidJSON returns a random JSON document that violates exactly one constraint of
+// `schema`.  See RandomInvalidJSON.
+func (g *Generator) RandomInvalidJSON(schema *JSONSchema) ([]byte, string, error) {
+	if err := schema.compile(); err != nil {
+		return nil, "", err
+	}
+	var targets []*jsonTarget
+	schema.targets(&targets, nil)
+	if len(targets) == 0 {
+		return nil, "", ErrUnsatisfiable
+	}
+	for i := 0; i < jsonTries; i++ {
+		tg := targets[g.IntN(len(targets))]
+		tg.done = false
+		data, errs, err := g.jsonDocument(schema, tg)
+		if err != nil {
+			return nil, "", err
+		}
+		if len(errs) == 1 {
+			return data, errs[0], nil
+		}
+	}
+	return nil, "", ErrUnsatisfiable
+}
+
+// jsonDocument generates a document and returns it with its violations of `schema`.
+func (g *Generator) jsonDocument(schema *JSONSchema, tg *jsonTarget) ([]byte, []string, error) {
+	v, err := g.jsonValue(schema, tg)
+	if err != nil {
+		return nil, nil, err
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, nil, err
+	}
+	var decoded interface{}
+	if err = json.Unmarshal(data, &decoded); err != nil {
+		return nil, nil, err
+	}
+	return data, schema.violations(decoded, ""), nil
+}
+
+// targets appends to `list` the constraints of the schema and its sub-schemas that a document can violate.
+func (s *JSONSchema) targets(list *[]*jsonTarget, parents []*JSONSchema) {
+	ancestors := map[*JSONSchema]bool{}
+	for _, p := range parents {
+		ancestors[p] = true
+	}
+	add := func(keyword string, arg string) {
+		*list = append(*list, &jsonTarget{node: s, keyword: keyword, arg: arg, ancestors: ancestors})
+	}
+	keywords := []struct {
+		present bool
+		keyword string
+	}{
+		{len(s.Type) != 0 && len(s.wrongTypes()) != 0, "type"},
+		{s.Enum != nil, "enum"},
+		{s.Const != nil, "const"},
+		{s.Minimum != nil, "minimum"},
+		{s.Maximum != nil, "maximum"},
+		{s.ExclusiveMinimum != nil, "exclusiveMinimum"},
+		{s.ExclusiveMaximum != nil, "exclusiveMaximum"},
+		{s.MinLength != nil && *s.MinLength > 0, "minLength"},
+		{s.MaxLength != nil, "maxLength"},
+		{s.pattern != nil, "pattern"},
+		{s.MinItems != nil && *s.MinItems > 0, "minItems"},
+		{s.MaxItems != nil, "maxItems"},
+		{s.AdditionalProperties != nil && !*s.AdditionalProperties, "additionalProperties"},
+	}
+	for _, k := range keywords {
+		if k.present {
+			add(k.keyword, "")
+		}
+	}
+	for _, r := range s.Required {
+		add("required", r)
+	}
+	parents = append(parents, s)
+	for _, name := range sortedProperties(s.Properties) {
+		s.Properties[name].targets(list, parents)
+	}
+	if s.Items != nil {
+		s.Items.targets(list, parents)
+	}
+}
+
+// leadsTo returns true if the target is `s` or one of its sub-schemas.
+func (tg *jsonTarget) leadsTo(s *JSONSchema) bool {
+	return tg != nil && !tg.done && (tg.node == s || tg.ancestors[s])
+}
+
+// jsonValue generates a value for the schema `s`.  If the target `tg` is not nil, the value
+// violates it once.
+func (g *Generator) jsonValue(s *JSONSchema, tg *jsonTarget) (interface{}, error) {
+	if tg != nil && !tg.done && tg.node == s {
+		tg.done = true
+		return g.violate(s, tg)
+	}
+	if s.Const != nil {
+		return s.Const, nil
+	}
+	if s.Enum != nil {
+		if len(s.Enum) == 0 {
+			return nil, ErrUnsatisfiable
+		}
+		return s.Enum[g.IntN(len(s.Enum))], nil
+	}
+	switch g.jsonTypeOf(s, tg) {
+	case typeObject:
+		return g.jsonObject(s, tg)
+	case typeArray:
+		return g.jsonArray(s, tg, -1)
+	case typeString:
+		return g.jsonString(s)
+	case typeInteger:
+		return g.jsonInteger(s)
+	case typeNumber:
+		return g.jsonNumber(s)
+	case typeBoolean:
+		return g.IntN(2) == 0, nil
+	default:
+		return nil, nil
+	}
+}
+
+// jsonTypeOf selects the type of the value generated for `s`.
+func (g *Generator) jsonTypeOf(s *JSONSchema, tg *jsonTarget) string {
+	if tg.leadsTo(s) && tg.node != s {
+		if s.Items != nil && tg.leadsTo(s.Items) {
+			return typeArray
+		}
+		return typeObject
+	}
+	types := s.Type
+	if len(types) == 0 {
+		types = s.inferredTypes()
+	}
+	return types[g.IntN(len(types))]
+}
+
+// inferredTypes returns the types suggested by the keywords of a schema without type.
+func (s *JSONSchema) inferredTypes() []string {
+	switch {
+	case s.Properties != nil || s.Required != nil || s.AdditionalProperties != nil:
+		return []string{typeObject}
+	case s.Items != nil || s.MinItems != nil || s.MaxItems != nil:
+		return []string{typeArray}
+	case s.pattern != nil || s.MinLength != nil || s.MaxLength != nil || s.Format != "":
+		return []string{typeString}
+	case s.Minimum != nil || s.Maximum != nil || s.ExclusiveMinimum != nil || s.ExclusiveMaximum != nil:
+		return []string{typeNumber}
+	default:
+		return []string{typeString, typeInteger, typeNumber, typeBoolean, typeNull}
+	}
+}
+
+// jsonObject generates an object with the required properties and some optional ones.
+func (g *Generator) jsonObject(s *JSONSchema, tg *jsonTarget) (map[string]interface{}, error) {
+	obj := map[string]interface{}{}
+	for _, name := range sortedProperties(s.Properties) {
+		p := s.Properties[name]
+		if !contains(s.Required, name) && !tg.leadsTo(p) && g.IntN(2) == 0 {
+			continue
+		}
+		v, err := g.jsonValue(p, tg)
+		if err != nil {
+			return nil, err
+		}
+		obj[name] = v
+	}
+	for _, name := range s.Required {
+		if _, ok := obj[name]; !ok {
+			obj[name] = g.RandomString(0)
+		}
+	}
+	return obj, nil
+}
+
+// jsonArray generates an array of `n` items.  If `n` is negative, the number of items respects
+// the schema.
+func (g *Generator) jsonArray(s *JSONSchema, tg *jsonTarget, n int) ([]interface{}, error) {
+	if n < 0 {
+		minimum, maximum := 0, defaultItems
+		if s.MinItems != nil {
+			minimum = *s.MinItems
+			maximum = minimum + defaultItems
+		}
+		if s.MaxItems != nil {
+			maximum = *s.MaxItems
+		}
+		if s.Items != nil && tg.leadsTo(s.Items) && minimum == 0 {
+			minimum = 1
+		}
+		if minimum > maximum {
+			return nil, ErrUnsatisfiable
+		}
+		n = g.between(minimum, maximum)
+	}
+	items := make([]interface{}, 0, n)
+	for i := 0; i < n; i++ {
+		var v interface{}
+		var err error
+		if s.Items == nil {
+			v = g.RandomAlphaString(0, AlphaNum)
+		} else if v, err = g.jsonValue(s.Items, tg); err != nil {
+			return nil, err
+		}
+		items = append(items, v)
+	}
+	return items, nil
+}
+
+// jsonString generates a string respecting the format, pattern and length of the schema.
+func (g *Generator) jsonString(s *JSONSchema) (string, error) {
+	minimum, maximum := 0, defaultLength
+	if s.MinLength != nil {
+		minimum = *s.MinLength
+		maximum = minimum + defaultLength
+	}
+	if s.MaxLength != nil {
+		maximum = *s.MaxLength
+	}
+	if minimum > maximum {
+		return "", ErrUnsatisfiable
+	}
+	if s.pattern == nil {
+		if f, ok := g.format(s.Format); ok {
+			return f, nil
+		}
+		n := g.between(minimum, maximum)
+		if n == 0 {
+			return "", nil
+		}
+		return g.RandomAlphaString(n, AlphaNum), nil
+	}
+	for i := 0; i < jsonTries; i++ {
+		str, err := g.RandomMatching(s.pattern.String())
+		if err != nil {
+			return "", err
+		}
+		if n := utf8.RuneCountInString(str); n >= minimum && n <= maximum {
+			return str, nil
+		}
+	}
+	return "", ErrUnsatisfiable
+}
+
+// format returns a random string of the JSON Schema `format`.  It returns false if the format is unknown.
+func (g *Generator) format(format string) (string, bool) {
+	switch format {
+	case "email", "idn-email":
+		return g.RandomEmail(Valid), true
+	case "hostname":
+		return g.RandomHostname(Valid), true
+	case "ipv4":
+		return g.RandomIPv4(Valid), true
+	case "ipv6":
+		return g.RandomIPv6(Valid), true
+	case "uri", "iri", "uri-reference":
+		return g.RandomURL(Valid), true
+	case "date-time":
+		return g.jsonTime().Format(time.RFC3339), true
+	case "date":
+		return g.jsonTime().Format(time.DateOnly), true
+	case "time":
+		return g.jsonTime().Format("15:04:05Z07:00"), true
+	case "uuid":
+		h := g.RandomAlphaString(32, Hex)
+		return h[:8] + "-" + h[8:12] + "-4" + h[13:16] + "-" + "89ab"[g.IntN(4):][:1] + h[17:20] + "-" + h[20:], true
+	default:
+		return "", false
+	}
+}
+
+// jsonTime returns a random time between 1970 and 2100.
+func (g *Generator) jsonTime() time.Time {
+	const end = 4102444800 // 2100-01-01
+	return time.Unix(g.Int64N(end), 0).UTC()
+}
+
+// bounds returns the range of the numbers of the schema.  The exclusive bounds are returned as is.
+func (s *JSONSchema) bounds() (float64, float64) {
+	lo, hi := -float64(defaultSpan), float64(defaultSpan)
+	if s.Minimum != nil {
+		lo = *s.Minimum
+	}
+	if s.ExclusiveMinimum != nil && *s.ExclusiveMinimum >= lo {
+		lo = *s.ExclusiveMinimum
+	}
+	if s.Maximum != nil {
+		hi = *s.Maximum
+		if s.Minimum == nil && s.ExclusiveMinimum == nil {
+			lo = hi - defaultSpan
+		}
+	}
+	if s.ExclusiveMaximum != nil && *s.ExclusiveMaximum <= hi {
+		hi = *s.ExclusiveMaximum
+		if s.Minimum == nil && s.ExclusiveMinimum == nil {
+			lo = hi - defaultSpan
+		}
+	}
+	if (s.Minimum != nil || s.ExclusiveMinimum != nil) && s.Maximum == nil && s.ExclusiveMaximum == nil {
+		hi = lo + defaultSpan
+	}
+	return lo, hi
+}
+
+// jsonInteger generates an integer in the range of the schema.
+func (g *Generator) jsonInteger(s *JSONSchema) (int64, error) {
+	lo, hi := s.bounds()
+	lo, hi = math.Ceil(lo), math.Floor(hi)
+	if lo > hi || lo >= maxInt64Float || hi < -maxInt64Float {
+		return 0, ErrUnsatisfiable
+	}
+	first, last := clampInt64(lo), clampInt64(hi)
+	if s.ExclusiveMinimum != nil && float64(first) <= *s.ExclusiveMinimum {
+		if first == math.MaxInt64 {
+			return 0, ErrUnsatisfiable
+		}
+		first++
+	}
+	if s.ExclusiveMaximum != nil && float64(last) >= *s.ExclusiveMaximum {
+		if last == math.MinInt64 {
+			return 0, ErrUnsatisfiable
+		}
+		last--
+	}
+	if first > last {
+		return 0, ErrUnsatisfiable
+	}
+	return g.int64Between(first, last), nil
+}
+
+// maxInt64Float is 2^63, the first float64 beyond the int64 range.
+const maxInt64Float = 0x1p63
+
+// clampInt64 converts `x` to an int64, clamped to the int64 range.
+func clampInt64(x float64) int64 {
+	switch {
+	case x >= maxInt64Float:
+		return math.MaxInt64
+	case x <= -maxInt64Float:
+		return math.MinInt64
+	}
+	return int64(x)
+}
+
+// jsonNumber generates a number in the range of the schema.
+func (g *Generator) jsonNumber(s *JSONSchema) (float64, error) {
+	lo, hi := s.bounds()
+	if lo > hi {
+		return 0, ErrUnsatisfiable
+	}
+	for i := 0; i < jsonTries; i++ {
+		// this interpolation does not overflow, unlike lo + u*(hi-lo).
+		u := g.Float64()
+		x := lo*(1-u) + hi*u
+		if len(s.numberViolations(x, "")) == 0 {
+			return x, nil
+		}
+	}
+	return 0, ErrUnsatisfiable
+}
+
+// violate generates a value of `s` that violates the target `tg`.
+func (g *Generator) violate(s *JSONSchema, tg *jsonTarget) (interface{}, error) {
+	relaxed := *s
+	switch tg.keyword {
+	case "type":
+		return g.wrongType(s), nil
+	case "enum":
+		relaxed.Enum = nil
+		return g.jsonValue(&relaxed, nil)
+	case "const":
+		relaxed.Const = nil
+		return g.jsonValue(&relaxed, nil)
+	case "minimum":
+		return math.Floor(*s.Minimum) - float64(g.IntN(defaultSpan)+1), nil
+	case "maximum":
+		return math.Ceil(*s.Maximum) + float64(g.IntN(defaultSpan)+1), nil
+	case "exclusiveMinimum":
+		return *s.ExclusiveMinimum, nil
+	case "exclusiveMaximum":
+		return *s.ExclusiveMaximum, nil
+	case "minLength":
+		relaxed.MinLength, relaxed.MaxLength = nil, intPtr(*s.MinLength-1)
+		return g.jsonString(&relaxed)
+	case "maxLength":
+		relaxed.MinLength, relaxed.MaxLength = intPtr(*s.MaxLength+1), nil
+		return g.jsonString(&relaxed)
+	case "pattern":
+		relaxed.pattern = nil
+		return g.jsonString(&relaxed)
+	case "minItems":
+		return g.jsonArray(s, nil, g.IntN(*s.MinItems))
+	case "maxItems":
+		return g.jsonArray(s, nil, *s.MaxItems+1+g.IntN(defaultItems))
+	case "required":
+		obj, err := g.jsonObject(s, nil)
+		if err != nil {
+			return nil, err
+		}
+		delete(obj, tg.arg)
+		return obj, nil
+	default: // additionalProperties
+		obj, err := g.jsonObject(s, nil)
+		if err != nil {
+			return nil, err
+		}
+		obj[strings.ToLower(g.RandomID())] = g.RandomString(0)
+		return obj, nil
+	}
+}
+
+// wrongTypes returns the types that the schema does not allow.
+func (s *JSONSchema) wrongTypes() []string {
+	var wrong []string
+	for _, t := range allTypes {
+		if !s.allows(t) {
+			wrong = append(wrong, t)
+		}
+	}
+	return wrong
+}
+
+// wrongType returns a value whose type the schema does not allow.
+func (g *Generator) wrongType(s *JSONSchema) interface{} {
+	wrong := s.wrongTypes()
+	switch wrong[g.IntN(len(wrong))] {
+	case typeObject:
+		return map[string]interface{}{}
+	case typeArray:
+		return []interface{}{}
+	case typeString:
+		return g.RandomAlphaString(0, AlphaNum)
+	case typeInteger:
+		return g.Int64N(defaultSpan)
+	case typeNumber:
+		return float64(g.IntN(defaultSpan)) + 0.5
+	case typeBoolean:
+		return true
+	default:
+		return nil
+	}
+}
+
+// sortedProperties returns the names of the properties in increasing order.
+func sortedProperties(m map[string]*JSONSchema) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// intPtr returns a pointer to `n`.
+func intPtr(n int) *int {
+	return &n
+}
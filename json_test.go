@@ -0,0 +1,106 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+const testJSONSchema = `{
+	"type": "object",
+	"required": ["id", "name", "tags"],
+	"additionalProperties": false,
+	"properties": {
+		"id": {"type": "integer", "minimum": 1, "maximum": 100},
+		"name": {"type": "string", "minLength": 3, "maxLength": 12},
+		"code": {"type": "string", "pattern": "^[A-Z]{3}-[0-9]{4}$"},
+		"email": {"type": "string", "format": "email"},
+		"ratio": {"type": "number", "exclusiveMinimum": 0, "exclusiveMaximum": 1},
+		"status": {"enum": ["new", "open", "closed"]},
+		"active": {"type": "boolean"},
+		"tags": {"type": "array", "minItems": 1, "maxItems": 3, "items": {"type": "string", "maxLength": 5}},
+		"owner": {
+			"type": ["object", "null"],
+			"required": ["age"],
+			"properties": {"age": {"type": "integer", "minimum": 18}}
+		}
+	}
+}`
+
+func Test_RandomJSON(t *testing.T) {
+	require, assert := Describe(t)
+
+	schema, err := ParseJSONSchema([]byte(testJSONSchema))
+	require.NoError(err)
+	g := Gen(t)
+	for i := 0; i < 100; i++ {
+		data, err := g.RandomJSON(schema)
+		require.NoError(err)
+		var v map[string]interface{}
+		require.NoError(json.Unmarshal(data, &v))
+		assert.Empty(schema.violations(v, ""), string(data))
+		assert.Contains(v, "tags")
+	}
+	_, err = ParseJSONSchema([]byte(`{"type": "foo"}`))
+	assert.Error(err)
+	_, err = ParseJSONSchema([]byte(`{"pattern": "("}`))
+	assert.Error(err)
+	schema, _ = ParseJSONSchema([]byte(`{"type": "integer", "minimum": 5, "maximum": 4}`))
+	_, err = g.RandomJSON(schema)
+	assert.ErrorIs(err, ErrUnsatisfiable)
+	schema, _ = ParseJSONSchema([]byte(`{"type": "integer", "minimum": -9e18, "maximum": 9e18}`))
+	_, err = g.RandomJSON(schema)
+	assert.NoError(err)
+	schema, _ = ParseJSONSchema([]byte(`{"type": "integer", "minimum": 1e19}`))
+	_, err = g.RandomJSON(schema)
+	assert.ErrorIs(err, ErrUnsatisfiable)
+	schema, _ = ParseJSONSchema([]byte(`{"type": "number", "minimum": -1e308, "maximum": 1e308}`))
+	data, err := g.RandomJSON(schema)
+	require.NoError(err)
+	var x float64
+	require.NoError(json.Unmarshal(data, &x))
+	assert.Empty(schema.violations(x, ""), string(data))
+
+	literal := &JSONSchema{Type: SchemaTypes{"string"}, Pattern: "^[a-f]{4}$"}
+	data, err = g.RandomJSON(literal)
+	require.NoError(err)
+	assert.Regexp("^\"[a-f]{4}\"$", string(data))
+	_, _, err = g.RandomInvalidJSON(&JSONSchema{Pattern: "("})
+	assert.Error(err)
+}
+
+func Test_RandomInvalidJSON(t *testing.T) {
+	require, assert := Describe(t)
+
+	schema, err := ParseJSONSchema([]byte(testJSONSchema))
+	require.NoError(err)
+	g := Gen(t)
+	seen := map[string]bool{}
+	for i := 0; i < 300; i++ {
+		data, violation, err := g.RandomInvalidJSON(schema)
+		require.NoError(err)
+		var v interface{}
+		require.NoError(json.Unmarshal(data, &v))
+		assert.Equal([]string{violation}, schema.violations(v, ""), string(data))
+		seen[violation] = true
+	}
+	assert.Greater(len(seen), 15)
+	schema, _ = ParseJSONSchema([]byte(`{}`))
+	_, _, err = g.RandomInvalidJSON(schema)
+	assert.ErrorIs(err, ErrUnsatisfiable)
+	schema, _ = ParseJSONSchema([]byte(`{"type": "object", "additionalProperties": false,
+		"properties": {"a": {"type": "array", "minItems": 5, "maxItems": 1}}}`))
+	for i := 0; i < loops; i++ {
+		data, violation, err := g.RandomInvalidJSON(schema)
+		if err != nil {
+			assert.ErrorIs(err, ErrUnsatisfiable)
+			continue
+		}
+		var v interface{}
+		require.NoError(json.Unmarshal(data, &v))
+		assert.Equal([]string{violation}, schema.violations(v, ""), string(data))
+	}
+}
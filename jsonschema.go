@@ -0,0 +1,272 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"unicode/utf8"
+)
+
+// JSONSchema is the subset of JSON Schema supported by RandomJSON.  The supported keywords are
+// type, properties, required, additionalProperties (boolean only), items, enum, const, minimum,
+// maximum, exclusiveMinimum, exclusiveMaximum (numbers), minLength, maxLength, pattern, format,
+// minItems and maxItems.
+type JSONSchema struct {
+	Type                 SchemaTypes            `json:"type,omitempty"`
+	Properties           map[string]*JSONSchema `json:"properties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	AdditionalProperties *bool                  `json:"additionalProperties,omitempty"`
+	Items                *JSONSchema            `json:"items,omitempty"`
+	Enum                 []interface{}          `json:"enum,omitempty"`
+	Const                interface{}            `json:"const,omitempty"`
+	Minimum              *float64               `json:"minimum,omitempty"`
+	Maximum              *float64               `json:"maximum,omitempty"`
+	ExclusiveMinimum     *float64               `json:"exclusiveMinimum,omitempty"`
+	ExclusiveMaximum     *float64               `json:"exclusiveMaximum,omitempty"`
+	MinLength            *int                   `json:"minLength,omitempty"`
+	MaxLength            *int                   `json:"maxLength,omitempty"`
+	Pattern              string                 `json:"pattern,omitempty"`
+	Format               string                 `json:"format,omitempty"`
+	MinItems             *int                   `json:"minItems,omitempty"`
+	MaxItems             *int                   `json:"maxItems,omitempty"`
+
+	pattern *regexp.Regexp
+}
+
+// SchemaTypes is the list of the JSON types allowed by a schema.  In JSON, it is either a string
+// or an array of strings.
+type SchemaTypes []string
+
+// The JSON types.
+const (
+	typeObject  = "object"
+	typeArray   = "array"
+	typeString  = "string"
+	typeInteger = "integer"
+	typeNumber  = "number"
+	typeBoolean = "boolean"
+	typeNull    = "null"
+)
+
+// allTypes lists the JSON types.
+var allTypes = []string{typeObject, typeArray, typeString, typeInteger, typeNumber, typeBoolean, typeNull}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (st *SchemaTypes) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*st = SchemaTypes{s}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+	*st = list
+	return nil
+}
+
+// ParseJSONSchema parses the JSON Schema `data`.
+func ParseJSONSchema(data []byte) (*JSONSchema, error) {
+	var s JSONSchema
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, err
+	}
+	if err := s.compile(); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// compile checks the types and compiles the patterns of the schema and its sub-schemas.  A pattern
+// already compiled is kept, thus it compiles lazily the schemas built as Go values.
+func (s *JSONSchema) compile() error {
+	for _, t := range s.Type {
+		if !contains(allTypes, t) {
+			return fmt.Errorf("unknown JSON type %q", t)
+		}
+	}
+	switch {
+	case s.Pattern == "":
+		s.pattern = nil
+	case s.pattern == nil || s.pattern.String() != s.Pattern:
+		re, err := regexp.Compile(s.Pattern)
+		if err != nil {
+			return err
+		}
+		s.pattern = re
+	}
+	for _, p := range s.Properties {
+		if err := p.compile(); err != nil {
+			return err
+		}
+	}
+	if s.Items != nil {
+		return s.Items.compile()
+	}
+	return nil
+}
+
+// violations returns the list of the constraints of the schema that `v` violates.  `v` is a
+// value decoded by encoding/json.  Each violation is described as "path: keyword".
+func (s *JSONSchema) violations(v interface{}, path string) []string {
+	var errs []string
+	add := func(keyword string) { errs = append(errs, path+": "+keyword) }
+	if len(s.Type) != 0 && !s.allows(jsonType(v)) {
+		add("type")
+		return errs
+	}
+	if s.Enum != nil && !containsValue(s.Enum, v) {
+		add("enum")
+	}
+	if s.Const != nil && !equalValues(s.Const, v) {
+		add("const")
+	}
+	switch x := v.(type) {
+	case float64:
+		errs = append(errs, s.numberViolations(x, path)...)
+	case string:
+		n := utf8.RuneCountInString(x)
+		if s.MinLength != nil && n < *s.MinLength {
+			add("minLength")
+		}
+		if s.MaxLength != nil && n > *s.MaxLength {
+			add("maxLength")
+		}
+		if s.pattern != nil && !s.pattern.MatchString(x) {
+			add("pattern")
+		}
+	case []interface{}:
+		if s.MinItems != nil && len(x) < *s.MinItems {
+			add("minItems")
+		}
+		if s.MaxItems != nil && len(x) > *s.MaxItems {
+			add("maxItems")
+		}
+		if s.Items != nil {
+			for i, item := range x {
+				errs = append(errs, s.Items.violations(item, fmt.Sprintf("%s/%d", path, i))...)
+			}
+		}
+	case map[string]interface{}:
+		errs = append(errs, s.objectViolations(x, path)...)
+	}
+	return errs
+}
+
+// numberViolations returns the list of the numeric constraints that `x` violates.
+func (s *JSONSchema) numberViolations(x float64, path string) []string {
+	var errs []string
+	if s.Minimum != nil && x < *s.Minimum {
+		errs = append(errs, path+": minimum")
+	}
+	if s.Maximum != nil && x > *s.Maximum {
+		errs = append(errs, path+": maximum")
+	}
+	if s.ExclusiveMinimum != nil && x <= *s.ExclusiveMinimum {
+		errs = append(errs, path+": exclusiveMinimum")
+	}
+	if s.ExclusiveMaximum != nil && x >= *s.ExclusiveMaximum {
+		errs = append(errs, path+": exclusiveMaximum")
+	}
+	return errs
+}
+
+// objectViolations returns the list of the object constraints that `x` violates.
+func (s *JSONSchema) objectViolations(x map[string]interface{}, path string) []string {
+	var errs []string
+	for _, r := range s.Required {
+		if _, ok := x[r]; !ok {
+			errs = append(errs, path+": required "+r)
+		}
+	}
+	for _, k := range sortedKeys(x) {
+		p, ok := s.Properties[k]
+		switch {
+		case ok:
+			errs = append(errs, p.violations(x[k], path+"/"+k)...)
+		case s.AdditionalProperties != nil && !*s.AdditionalProperties:
+			errs = append(errs, path+": additionalProperties "+k)
+		}
+	}
+	return errs
+}
+
+// allows returns true if the schema allows the JSON type `t`.  An integer is also a number.
+func (s *JSONSchema) allows(t string) bool {
+	return contains(s.Type, t) || (t == typeInteger && contains(s.Type, typeNumber))
+}
+
+// jsonType returns the JSON type of `v`.  The numbers without fractional part are integers.
+func jsonType(v interface{}) string {
+	switch x := v.(type) {
+	case nil:
+		return typeNull
+	case bool:
+		return typeBoolean
+	case float64:
+		if x == math.Trunc(x) && !math.IsInf(x, 0) {
+			return typeInteger
+		}
+		return typeNumber
+	case string:
+		return typeString
+	case []interface{}:
+		return typeArray
+	default:
+		return typeObject
+	}
+}
+
+// contains returns true if `list` contains `s`.
+func contains(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
+
+// containsValue returns true if `list` contains a value equal to `v`.
+func containsValue(list []interface{}, v interface{}) bool {
+	for _, e := range list {
+		if equalValues(e, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// equalValues returns true if the JSON values `a` and `b` are equal.
+func equalValues(a interface{}, b interface{}) bool {
+	return reflect.DeepEqual(normalize(a), normalize(b))
+}
+
+// normalize returns `v` as decoded by encoding/json, so that numbers are float64.
+func normalize(v interface{}) interface{} {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return v
+	}
+	var n interface{}
+	_ = json.Unmarshal(data, &n)
+	return n
+}
+
+// sortedKeys returns the keys of `m` in increasing order.
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
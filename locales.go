@@ -0,0 +1,133 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+// builtinLocales lists the locales embedded in the package.  The postal codes, area codes and mobile
+// prefixes follow the real plans, but the generated numbers are random.
+var builtinLocales = []Locale{
+	{
+		Tag:     "en-US",
+		Country: "United States",
+		FirstNames: []string{"James", "Mary", "Robert", "Patricia", "John", "Jennifer", "Michael", "Linda", "David",
+			"Elizabeth", "William", "Barbara", "Richard", "Susan", "Joseph", "Jessica", "Thomas", "Sarah", "Daniel",
+			"Karen", "Matthew", "Nancy", "Anthony", "Lisa", "Mark", "Betty", "Tyler", "Ashley", "DeShawn", "Maria"},
+		LastNames: []string{"Smith", "Johnson", "Williams", "Brown", "Jones", "Garcia", "Miller", "Davis",
+			"Rodriguez", "Martinez", "Hernandez", "Lopez", "Gonzalez", "Wilson", "Anderson", "Thomas", "Taylor",
+			"Moore", "Jackson", "Martin", "Lee", "Nguyen", "O'Brien", "Clark", "Walker", "Young", "Allen", "King"},
+		NameFormat: "{first} {last}",
+		Streets: []string{"Main Street", "Oak Avenue", "Maple Drive", "Cedar Lane", "Park Avenue", "Washington Street",
+			"Lake Road", "Elm Street", "Pine Court", "Sunset Boulevard", "Hillcrest Drive", "Church Street"},
+		StreetNumber: "N###",
+		Cities: []City{
+			{"New York", "NY", "100##"}, {"Los Angeles", "CA", "900##"}, {"Chicago", "IL", "606##"},
+			{"Houston", "TX", "770##"}, {"Phoenix", "AZ", "850##"}, {"Philadelphia", "PA", "191##"},
+			{"Seattle", "WA", "981##"}, {"Denver", "CO", "802##"}, {"Boston", "MA", "021##"},
+			{"Miami", "FL", "331##"}, {"Springfield", "IL", "627##"}, {"Anchorage", "AK", "995##"},
+		},
+		AddressFormat: "{number} {street}\n{city}, {region} {postal}",
+		Phones:        []string{"(N##) N##-####", "+1 N##-N##-####", "N##-N##-####", "N##.N##.####"},
+		Companies: []string{"{last} Inc.", "{last} LLC", "{last} & Sons", "{last} Group", "{last} Holdings Corp.",
+			"{last}-{last} Partners"},
+	},
+	{
+		Tag:     "fr-FR",
+		Country: "France",
+		FirstNames: []string{"Jean", "Marie", "Pierre", "Nathalie", "Michel", "Isabelle", "Philippe", "Sylvie",
+			"Alain", "Catherine", "Nicolas", "Françoise", "François", "Valérie", "Hélène", "Jérôme", "Camille",
+			"Léa", "Chloé", "Maël", "Anaïs", "Théo", "Zoé", "Jean-Baptiste", "Marie-Claire", "Gaëlle"},
+		LastNames: []string{"Martin", "Bernard", "Dubois", "Thomas", "Robert", "Richard", "Petit", "Durand",
+			"Leroy", "Moreau", "Simon", "Laurent", "Lefèvre", "Michel", "Garcia", "David", "Bertrand", "Roux",
+			"Vincent", "Fournier", "Girard", "Bonnet", "Dupont", "Lambert", "Rousseau", "Mercier", "Le Goff",
+			"N'Diaye"},
+		NameFormat: "{first} {last}",
+		Streets: []string{"rue de la Paix", "avenue Victor Hugo", "boulevard Saint-Michel", "rue du Faubourg Saint-Honoré",
+			"place de la République", "rue Émile Zola", "allée des Tilleuls", "impasse des Lilas", "quai de la Tournelle",
+			"chemin des Écoliers", "rue Jean Jaurès", "cours Mirabeau"},
+		StreetNumber: "N#",
+		Cities: []City{
+			{"Paris", "", "750##"}, {"Marseille", "", "130##"}, {"Lyon", "", "6900#"}, {"Toulouse", "", "310##"},
+			{"Nice", "", "06##0"}, {"Nantes", "", "440##"}, {"Strasbourg", "", "670##"}, {"Bordeaux", "", "330##"},
+			{"Lille", "", "590##"}, {"Rennes", "", "350##"}, {"Aix-en-Provence", "", "13090"}, {"Ajaccio", "", "20000"},
+		},
+		AddressFormat: "{number} {street}\n{postal} {city}",
+		Phones:        []string{"01 ## ## ## ##", "04 ## ## ## ##", "06 ## ## ## ##", "07 ## ## ## ##", "+33 6 ## ## ## ##"},
+		Companies:     []string{"{last} SA", "{last} et Fils", "SARL {last}", "Établissements {last}", "{last} & Associés"},
+	},
+	{
+		Tag:     "de-DE",
+		Country: "Deutschland",
+		FirstNames: []string{"Peter", "Ursula", "Wolfgang", "Monika", "Klaus", "Petra", "Jürgen", "Sabine",
+			"Thomas", "Andrea", "Michael", "Birgit", "Stefan", "Jörg", "Günter", "Lukas", "Sophie", "Jonas",
+			"Mia", "Leon", "Hannah", "Maximilian", "Lena", "Björn", "Anja", "Karl-Heinz"},
+		LastNames: []string{"Müller", "Schmidt", "Schneider", "Fischer", "Weber", "Meyer", "Wagner", "Becker",
+			"Schulz", "Hoffmann", "Schäfer", "Koch", "Bauer", "Richter", "Klein", "Wolf", "Schröder",
+			"Neumann", "Schwarz", "Zimmermann", "Braun", "Krüger", "Hofmann", "Hartmann", "Lange", "Weiß"},
+		NameFormat: "{first} {last}",
+		Streets: []string{"Hauptstraße", "Schulstraße", "Bahnhofstraße", "Gartenstraße", "Dorfstraße", "Bergstraße",
+			"Birkenweg", "Lindenstraße", "Kirchstraße", "Am Markt", "Goethestraße", "Schillerplatz"},
+		StreetNumber: "N#",
+		Cities: []City{
+			{"Berlin", "", "10###"}, {"Hamburg", "", "20###"}, {"München", "", "80###"}, {"Köln", "", "50###"},
+			{"Frankfurt am Main", "", "60###"}, {"Stuttgart", "", "70###"}, {"Düsseldorf", "", "40###"},
+			{"Leipzig", "", "04###"}, {"Dresden", "", "01###"}, {"Nürnberg", "", "90###"}, {"Bremen", "", "28###"},
+		},
+		AddressFormat: "{street} {number}\n{postal} {city}",
+		Phones:        []string{"030 #######", "089 #######", "040 ########", "0151 ########", "+49 170 #######"},
+		Companies:     []string{"{last} GmbH", "{last} AG", "{last} & {last} KG", "{last} GmbH & Co. KG", "Gebrüder {last}"},
+	},
+	{
+		Tag:     "pt-BR",
+		Country: "Brasil",
+		FirstNames: []string{"Maria", "José", "Ana", "João", "Antônio", "Francisca", "Carlos", "Adriana",
+			"Paulo", "Juliana", "Pedro", "Márcia", "Lucas", "Fernanda", "Luiz", "Patrícia", "Gabriel",
+			"Aline", "Rafael", "Letícia", "Thiago", "Beatriz", "Matheus", "Conceição", "Raimundo", "Luíza"},
+		LastNames: []string{"Silva", "Santos", "Oliveira", "Souza", "Rodrigues", "Ferreira", "Alves", "Pereira",
+			"Lima", "Gomes", "Costa", "Ribeiro", "Martins", "Carvalho", "Almeida", "Lopes", "Soares",
+			"Fernandes", "Vieira", "Barbosa", "Araújo", "Nascimento", "Conceição", "Magalhães", "Assunção"},
+		NameFormat: "{first} {last}",
+		Streets: []string{"Rua das Flores", "Avenida Paulista", "Rua Sete de Setembro", "Avenida Brasil",
+			"Rua XV de Novembro", "Rua São João", "Avenida Atlântica", "Travessa da Paz", "Rua Barão do Rio Branco",
+			"Praça da Sé", "Alameda Santos", "Estrada do Coco"},
+		StreetNumber: "N##",
+		Cities: []City{
+			{"São Paulo", "SP", "01###-###"}, {"Rio de Janeiro", "RJ", "20###-###"}, {"Belo Horizonte", "MG", "30###-###"},
+			{"Salvador", "BA", "40###-###"}, {"Curitiba", "PR", "80###-###"}, {"Fortaleza", "CE", "60###-###"},
+			{"Recife", "PE", "50###-###"}, {"Porto Alegre", "RS", "90###-###"}, {"Manaus", "AM", "69###-###"},
+			{"Brasília", "DF", "70###-###"}, {"Goiânia", "GO", "74###-###"},
+		},
+		AddressFormat: "{street}, {number}\n{city} - {region}\n{postal}",
+		Phones:        []string{"(11) 9####-####", "(21) 9####-####", "(31) N###-####", "+55 11 9####-####", "(61) 3###-####"},
+		Companies:     []string{"{last} Ltda.", "{last} & Filhos", "{last} S.A.", "Comércio {last} Ltda.", "{last} e {last} Advogados"},
+	},
+	{
+		Tag:     "ja-JP",
+		Country: "日本",
+		FirstNames: []string{"翔太", "蓮", "陽翔", "大翔", "悠真", "湊", "大輝", "拓海", "健太", "浩",
+			"結衣", "陽菜", "さくら", "美咲", "葵", "凛", "結菜", "愛", "由美子", "恵子", "ひなた", "あおい"},
+		LastNames: []string{"佐藤", "鈴木", "高橋", "田中", "伊藤", "渡辺", "山本", "中村", "小林", "加藤",
+			"吉田", "山田", "佐々木", "山口", "松本", "井上", "木村", "林", "斎藤", "清水", "長谷川", "五十嵐"},
+		NameFormat: "{last} {first}",
+		Streets: []string{"千代田", "丸の内", "西新宿", "梅田", "河原町", "大通西", "栄", "中央", "本町", "天神",
+			"桜木町", "元町"},
+		StreetNumber: "N丁目N-N",
+		Cities: []City{
+			{"千代田区", "東京都", "100-00##"}, {"新宿区", "東京都", "160-0###"}, {"大阪市北区", "大阪府", "530-00##"},
+			{"京都市中京区", "京都府", "604-8###"}, {"札幌市中央区", "北海道", "060-00##"},
+			{"名古屋市中区", "愛知県", "460-00##"}, {"福岡市中央区", "福岡県", "810-00##"},
+			{"横浜市中区", "神奈川県", "231-00##"}, {"那覇市", "沖縄県", "900-00##"},
+		},
+		AddressFormat: "〒{postal}\n{region}{city}{street}{number}",
+		Phones:        []string{"03-####-####", "06-####-####", "090-####-####", "080-####-####", "+81 3-####-####"},
+		Companies:     []string{"株式会社{last}商事", "{last}工業株式会社", "{last}電機株式会社", "有限会社{last}製作所", "{last}建設株式会社"},
+	},
+}
+
+func init() {
+	for _, l := range builtinLocales {
+		if err := RegisterLocale(l); err != nil {
+			panic(err)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+)
+
+// fixtureDir is the directory of the regression fixtures.
+const fixtureDir = "testdata"
+
+// MinimizeBytes returns a locally minimal sub-sequence of `input` for which `fails` still returns
+// true, i.e., removing any single byte makes it pass.  It uses the ddmin algorithm of delta
+// debugging, which removes chunks of decreasing size.  If `input` does not fail, it is returned
+// unchanged.
+func MinimizeBytes(input []byte, fails func([]byte) bool) []byte {
+	build := func(keep []int) []byte {
+		p := make([]byte, len(keep))
+		for i, k := range keep {
+			p[i] = input[k]
+		}
+		return p
+	}
+	if !fails(input) {
+		return input
+	}
+	return build(ddmin(len(input), func(keep []int) bool { return fails(build(keep)) }))
+}
+
+// MinimizeString returns a locally minimal sub-sequence of the runes of `input` for which `fails`
+// still returns true.  See MinimizeBytes.
+func MinimizeString(input string, fails func(string) bool) string {
+	runes := []rune(input)
+	build := func(keep []int) string {
+		var sb strings.Builder
+		for _, k := range keep {
+			sb.WriteRune(runes[k])
+		}
+		return sb.String()
+	}
+	if !fails(input) {
+		return input
+	}
+	return build(ddmin(len(runes), func(keep []int) bool { return fails(build(keep)) }))
+}
+
+// MinimizeLines returns a locally minimal sub-sequence of the lines of `input` for which `fails`
+// still returns true.  The lines keep their end of line.  See MinimizeBytes.
+func MinimizeLines(input string, fails func(string) bool) string {
+	lines := strings.SplitAfter(input, "\n")
+	build := func(keep []int) string {
+		var sb strings.Builder
+		for _, k := range keep {
+			sb.WriteString(lines[k])
+		}
+		return sb.String()
+	}
+	if !fails(input) {
+		return input
+	}
+	return build(ddmin(len(lines), func(keep []int) bool { return fails(build(keep)) }))
+}
+
+// MinimizeSlice returns a locally minimal sub-sequence of the elements of `slice` for which `fails`
+// still returns true.  `slice` is a slice of any type []T and `fails` a func([]T) bool.  The
+// result is a []T.  See MinimizeBytes.
+func MinimizeSlice(slice interface{}, fails interface{}) (interface{}, error) {
+	s, f := reflect.ValueOf(slice), reflect.ValueOf(fails)
+	if s.Kind() != reflect.Slice {
+		return nil, errors.New("MinimizeSlice needs a slice")
+	}
+	if f.Kind() != reflect.Func || f.Type().NumIn() != 1 || f.Type().In(0) != s.Type() ||
+		f.Type().NumOut() != 1 || f.Type().Out(0).Kind() != reflect.Bool {
+		return nil, errors.New("MinimizeSlice needs a func(" + s.Type().String() + ") bool")
+	}
+	build := func(keep []int) reflect.Value {
+		p := reflect.MakeSlice(s.Type(), len(keep), len(keep))
+		for i, k := range keep {
+			p.Index(i).Set(s.Index(k))
+		}
+		return p
+	}
+	call := func(v reflect.Value) bool { return f.Call([]reflect.Value{v})[0].Bool() }
+	if !call(s) {
+		return slice, nil
+	}
+	return build(ddmin(s.Len(), func(keep []int) bool { return call(build(keep)) })).Interface(), nil
+}
+
+// WriteFixture writes `data` to the file `name` of the directory testdata, which it creates if
+// needed, so that a minimized input becomes a regression fixture.  It returns the path of the file.
+func WriteFixture(name string, data []byte) (string, error) {
+	if err := os.MkdirAll(fixtureDir, 0755); err != nil {
+		return "", err
+	}
+	p := filepath.Join(fixtureDir, filepath.Clean(string(filepath.Separator)+name))
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		return "", err
+	}
+	return p, os.WriteFile(p, data, 0644)
+}
+
+// ddmin returns the indices of a 1-minimal failing sub-sequence of the sequence of `n` elements.
+// `fails` tells whether the sub-sequence made of the elements of the sorted indices `keep` fails.
+// The whole sequence is assumed to fail.
+func ddmin(n int, fails func(keep []int) bool) []int {
+	current := make([]int, n)
+	for i := range current {
+		current[i] = i
+	}
+	granularity := 2
+	for len(current) >= 2 {
+		chunks := chunksOf(current, granularity)
+		reduced := false
+		// first, tries each chunk alone, then each complement.  With two chunks, the complements are
+		// the chunks.
+		for _, c := range chunks {
+			if fails(c) {
+				current, granularity, reduced = c, 2, true
+				break
+			}
+		}
+		for i := 0; i < len(chunks) && !reduced && granularity > 2; i++ {
+			if c := complement(chunks, i); fails(c) {
+				current, reduced = c, true
+				if granularity--; granularity < 2 {
+					granularity = 2
+				}
+			}
+		}
+		if reduced {
+			continue
+		}
+		if granularity >= len(current) {
+			break
+		}
+		if granularity *= 2; granularity > len(current) {
+			granularity = len(current)
+		}
+	}
+	if len(current) == 1 && fails(nil) {
+		return nil
+	}
+	return current
+}
+
+// chunksOf splits `list` into `n` chunks of almost equal size.
+func chunksOf(list []int, n int) [][]int {
+	chunks := make([][]int, 0, n)
+	start := 0
+	for i := 0; i < n; i++ {
+		end := start + (len(list)-start)/(n-i)
+		chunks = append(chunks, list[start:end])
+		start = end
+	}
+	return chunks
+}
+
+// complement returns the concatenation of the chunks except the chunk `i`.
+func complement(chunks [][]int, i int) []int {
+	var c []int
+	for j, chunk := range chunks {
+		if j != i {
+			c = append(c, chunk...)
+		}
+	}
+	return c
+}
@@ -0,0 +1,109 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func Test_MinimizeBytes(t *testing.T) {
+	_, assert := Describe(t)
+
+	g := Gen(t)
+	// the "parser" crashes when the input holds a 0xff then, later, a 0x00.
+	crashes := func(p []byte) bool {
+		i := bytes.IndexByte(p, 0xff)
+		return i >= 0 && bytes.IndexByte(p[i:], 0x00) >= 0
+	}
+	input := append(append(bytes.Repeat([]byte{1}, 1000), 0xff), g.RandomSlice(5000)...)
+	input = append(input, 0)
+	calls := 0
+	out := MinimizeBytes(input, func(p []byte) bool { calls++; return crashes(p) })
+	assert.Equal([]byte{0xff, 0x00}, out)
+	assert.Less(calls, 2000)
+
+	assert.Equal([]byte("abc"), MinimizeBytes([]byte("abc"), func([]byte) bool { return false }))
+	assert.Empty(MinimizeBytes([]byte("abc"), func([]byte) bool { return true }))
+}
+
+func Test_MinimizeString(t *testing.T) {
+	_, assert := Describe(t)
+
+	input := RandomAlphaString(200, Small) + "日本" + RandomAlphaString(200, Small)
+	out := MinimizeString(input, func(s string) bool { return strings.Contains(s, "日") && strings.Contains(s, "本") })
+	assert.Equal("日本", out)
+}
+
+func Test_MinimizeLines(t *testing.T) {
+	_, assert := Describe(t)
+
+	var sb strings.Builder
+	for i := 0; i < 100; i++ {
+		sb.WriteString(RandomName(10) + "\n")
+		if i == 42 {
+			sb.WriteString("BEGIN\n")
+		}
+		if i == 77 {
+			sb.WriteString("END\n")
+		}
+	}
+	out := MinimizeLines(sb.String(), func(s string) bool {
+		return strings.Contains(s, "BEGIN\n") && strings.Contains(s, "END\n")
+	})
+	assert.Equal("BEGIN\nEND\n", out)
+}
+
+func Test_MinimizeSlice(t *testing.T) {
+	require, assert := Describe(t)
+
+	type event struct {
+		Kind string
+		N    int
+	}
+	var events []event
+	for i := 0; i < 300; i++ {
+		events = append(events, event{Kind: "tick", N: i})
+	}
+	events[100].Kind, events[250].Kind = "open", "close"
+	out, err := MinimizeSlice(events, func(e []event) bool {
+		opened := false
+		for _, x := range e {
+			opened = opened || x.Kind == "open"
+			if opened && x.Kind == "close" {
+				return true
+			}
+		}
+		return false
+	})
+	require.NoError(err)
+	assert.Equal([]event{{"open", 100}, {"close", 250}}, out)
+
+	_, err = MinimizeSlice(3, func([]int) bool { return true })
+	assert.Error(err)
+	_, err = MinimizeSlice([]int{1}, func([]string) bool { return true })
+	assert.Error(err)
+}
+
+func Test_WriteFixture(t *testing.T) {
+	require, assert := Describe(t)
+
+	name := "minimized/" + RandomID() + ".bin"
+	p, err := WriteFixture(name, []byte{0xff, 0})
+	require.NoError(err)
+	defer func() { _ = os.RemoveAll(filepath.Join("testdata", "minimized")) }()
+	assert.Equal(filepath.Join("testdata", filepath.FromSlash(name)), p)
+	data, err := os.ReadFile(p)
+	require.NoError(err)
+	assert.Equal([]byte{0xff, 0}, data)
+
+	// the fixture stays in testdata.
+	p, err = WriteFixture("../minimized/escape", nil)
+	require.NoError(err)
+	assert.Equal(filepath.Join("testdata", "minimized", "escape"), p)
+}
@@ -0,0 +1,257 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Mutation describes a change made by a StringMutator.
+type Mutation struct {
+	// Kind is the name of the mutator, for instance "homoglyph".
+	Kind string
+	// Offset is the byte offset of the change in the string given to the mutator.
+	Offset int
+	From   string
+	To     string
+}
+
+// String returns a readable description of the mutation, with the non-ASCII characters escaped.
+func (m Mutation) String() string {
+	return fmt.Sprintf("%s at byte %d: %+q -> %+q", m.Kind, m.Offset, m.From, m.To)
+}
+
+// Mutations is the report of MutateString.
+type Mutations []Mutation
+
+// String returns the description of the mutations, one per line.  It is meant for the messages of
+// the assertions.
+func (ms Mutations) String() string {
+	lines := make([]string, len(ms))
+	for i, m := range ms {
+		lines[i] = m.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// StringMutator changes a string and reports its mutations.  It changes a random subset of the
+// places it may change, at least one if any.
+type StringMutator func(g *Generator, s string) (string, []Mutation)
+
+// edit is a candidate change of the bytes start to end of a string.
+type edit struct {
+	start int
+	end   int
+	to    []string
+}
+
+var (
+	// homoglyphs maps ASCII characters to look-alike characters of other scripts.
+	homoglyphs = map[rune][]string{
+		'a': {"а", "ɑ"}, 'c': {"с", "ϲ"}, 'e': {"е"}, 'i': {"і", "ı"},
+		'j': {"ј"}, 'o': {"о", "ο", "0"}, 'p': {"р", "ρ"}, 's': {"ѕ"},
+		'x': {"х", "×"}, 'y': {"у"}, 'l': {"1", "I", "ӏ"}, 'v': {"ν"},
+		'A': {"А", "Α"}, 'B': {"В", "Β"}, 'C': {"С"}, 'E': {"Е", "Ε"},
+		'H': {"Н", "Η"}, 'I': {"І", "l", "1"}, 'K': {"К", "Κ"}, 'M': {"М"},
+		'N': {"Ν"}, 'O': {"О", "Ο", "0"}, 'P': {"Р", "Ρ"}, 'S': {"Ѕ"},
+		'T': {"Т", "Τ"}, 'X': {"Х", "Χ"}, 'Y': {"Ү", "Υ"}, 'Z': {"Ζ"},
+		'0': {"O", "О"}, '1': {"l", "I"}, '-': {"\u2010", "\u2212", "\u2013"}, '.': {"\u2024"},
+	}
+
+	// whitespaces lists the replacements of a space.
+	whitespaces = []string{"\t", "\u00a0", "  ", "\u2009", "\u202f", "\u3000"}
+
+	// compositions maps each combining mark to the pairs of base letter and precomposed letter of
+	// Latin-1 and Latin Extended-A.
+	compositions = map[rune]string{
+		'\u0300': "AÀEÈIÌOÒUÙaàeèiìoòuù",
+		'\u0301': "AÁEÉIÍOÓUÚYÝaáeéiíoóuúyýCĆcćNŃnńSŚsśZŹzźLĹlĺRŔrŕ",
+		'\u0302': "AÂEÊIÎOÔUÛaâeêiîoôuûCĈcĉGĜgĝHĤhĥJĴjĵSŜsŝWŴwŵYŶyŷ",
+		'\u0303': "AÃNÑOÕaãnñoõIĨiĩUŨuũ",
+		'\u0304': "AĀaāEĒeēIĪiīOŌoōUŪuū",
+		'\u0306': "AĂaăEĔeĕGĞgğIĬiĭOŎoŏUŬuŭ",
+		'\u0307': "CĊcċEĖeėGĠgġZŻzżIİ",
+		'\u0308': "AÄEËIÏOÖUÜaäeëiïoöuüyÿYŸ",
+		'\u030a': "AÅaåUŮuů",
+		'\u030b': "OŐoőUŰuű",
+		'\u030c': "CČcčDĎdďEĚeěNŇnňRŘrřSŠsšTŤtťZŽzžLĽlľ",
+		'\u0327': "CÇcçSŞsşTŢtţGĢgģKĶkķLĻlļNŅnņRŖrŗ",
+		'\u0328': "AĄaąEĘeęIĮiįUŲuų",
+	}
+
+	// decompositions maps the precomposed letters to their canonical decomposition.
+	decompositions = map[rune]string{}
+	// composed maps the canonical decompositions to their precomposed letters.
+	composed = map[string]rune{}
+)
+
+func init() {
+	for mark, pairs := range compositions {
+		runes := []rune(pairs)
+		for i := 0; i+1 < len(runes); i += 2 {
+			d := string([]rune{runes[i], mark})
+			decompositions[runes[i+1]] = d
+			composed[d] = runes[i+1]
+		}
+	}
+}
+
+// MutateString applies the `mutators` in turn to `s` and returns the result with the report of the
+// mutations.  Without mutators, it applies one of the built-in mutators at random.
+func MutateString(s string, mutators ...StringMutator) (string, Mutations) {
+	return Default().MutateString(s, mutators...)
+}
+
+// MutateString applies the `mutators` in turn to `s`.  See MutateString.
+func (g *Generator) MutateString(s string, mutators ...StringMutator) (string, Mutations) {
+	if len(mutators) == 0 {
+		all := []StringMutator{CaseMutator(), NormalizationMutator(), HomoglyphMutator(), WhitespaceMutator(),
+			FullWidthMutator(), ZeroWidthMutator()}
+		mutators = []StringMutator{all[g.IntN(len(all))]}
+	}
+	var report Mutations
+	for _, m := range mutators {
+		var ms []Mutation
+		s, ms = m(g, s)
+		report = append(report, ms...)
+	}
+	return s, report
+}
+
+// CaseMutator returns a StringMutator changing the case of letters, as SwapCase.
+func CaseMutator() StringMutator {
+	return runeMutator("case", func(r rune) []string {
+		var to []string
+		for _, c := range []rune{unicode.ToUpper(r), unicode.ToLower(r), unicode.ToTitle(r)} {
+			if c != r {
+				to = append(to, string(c))
+			}
+		}
+		return to
+	})
+}
+
+// HomoglyphMutator returns a StringMutator replacing ASCII characters with confusable ones, mostly
+// Cyrillic and Greek letters such as "а" (U+0430) for "a".
+func HomoglyphMutator() StringMutator {
+	return runeMutator("homoglyph", func(r rune) []string { return homoglyphs[r] })
+}
+
+// FullWidthMutator returns a StringMutator replacing printable ASCII characters with their
+// full-width forms, such as "Ａ" (U+FF21) for "A", and the spaces with the ideographic space.
+func FullWidthMutator() StringMutator {
+	return runeMutator("full-width", func(r rune) []string {
+		switch {
+		case r == ' ':
+			return []string{"\u3000"}
+		case r > ' ' && r <= '~':
+			return []string{string(r - '!' + '！')}
+		}
+		return nil
+	})
+}
+
+// ZeroWidthMutator returns a StringMutator inserting invisible characters, such as the zero-width
+// space, the zero-width joiner or the byte order mark, after characters.
+func ZeroWidthMutator() StringMutator {
+	return runeMutator("zero-width", func(r rune) []string {
+		to := make([]string, len(zeroWidths))
+		for i, z := range zeroWidths {
+			to[i] = string(r) + z
+		}
+		return to
+	})
+}
+
+// WhitespaceMutator returns a StringMutator replacing spaces with tabulations, non-breaking spaces,
+// double spaces or other Unicode spaces, and adding leading or trailing spaces.
+func WhitespaceMutator() StringMutator {
+	return func(g *Generator, s string) (string, []Mutation) {
+		edits := []edit{{start: 0, end: 0, to: []string{" ", "\t", "\u00a0"}}}
+		for i, r := range s {
+			if r == ' ' {
+				edits = append(edits, edit{start: i, end: i + 1, to: whitespaces})
+			}
+		}
+		edits = append(edits, edit{start: len(s), end: len(s), to: []string{" ", "  ", "\t", "\n", "\r\n"}})
+		return g.applyEdits(s, "whitespace", edits)
+	}
+}
+
+// NormalizationMutator returns a StringMutator switching between the Unicode normalization forms
+// NFC and NFD: it decomposes precomposed letters, such as "é" into "e" and U+0301, and composes the
+// decomposed ones.  It knows the letters with diacritics of Latin-1 and Latin Extended-A.
+func NormalizationMutator() StringMutator {
+	return func(g *Generator, s string) (string, []Mutation) {
+		var edits []edit
+		for i := 0; i < len(s); {
+			r, n := utf8.DecodeRuneInString(s[i:])
+			m, nm := utf8.DecodeRuneInString(s[i+n:])
+			if c, ok := composed[string([]rune{r, m})]; ok && nm > 0 {
+				edits = append(edits, edit{start: i, end: i + n + nm, to: []string{string(c)}})
+				i += n + nm
+				continue
+			}
+			if d, ok := decompositions[r]; ok {
+				edits = append(edits, edit{start: i, end: i + n, to: []string{d}})
+			}
+			i += n
+		}
+		return g.applyEdits(s, "normalization", edits)
+	}
+}
+
+// runeMutator returns a StringMutator named `kind` replacing the runes for which `replace` returns
+// replacements.  The invalid UTF-8 bytes are kept.
+func runeMutator(kind string, replace func(r rune) []string) StringMutator {
+	return func(g *Generator, s string) (string, []Mutation) {
+		var edits []edit
+		for i := 0; i < len(s); {
+			r, n := utf8.DecodeRuneInString(s[i:])
+			if r != utf8.RuneError || n > 1 {
+				if to := replace(r); len(to) != 0 {
+					edits = append(edits, edit{start: i, end: i + n, to: to})
+				}
+			}
+			i += n
+		}
+		return g.applyEdits(s, kind, edits)
+	}
+}
+
+// applyEdits applies a random subset of the sorted, non-overlapping `edits` to `s`, at least one if
+// any, and reports them.
+func (g *Generator) applyEdits(s string, kind string, edits []edit) (string, []Mutation) {
+	if len(edits) == 0 {
+		return s, nil
+	}
+	chosen := make([]bool, len(edits))
+	some := false
+	for i := range chosen {
+		chosen[i] = g.IntN(2) == 0
+		some = some || chosen[i]
+	}
+	if !some {
+		chosen[g.IntN(len(edits))] = true
+	}
+	var sb strings.Builder
+	var ms []Mutation
+	last := 0
+	for i, e := range edits {
+		if !chosen[i] {
+			continue
+		}
+		to := g.pickString(e.to)
+		sb.WriteString(s[last:e.start])
+		sb.WriteString(to)
+		ms = append(ms, Mutation{Kind: kind, Offset: e.start, From: s[e.start:e.end], To: to})
+		last = e.end
+	}
+	sb.WriteString(s[last:])
+	return sb.String(), ms
+}
@@ -0,0 +1,94 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"testing"
+	"unicode"
+	"unicode/utf8"
+)
+
+// replay applies the mutations of one mutator to `s`.
+func replay(s string, ms []Mutation) string {
+	for i := len(ms) - 1; i >= 0; i-- {
+		m := ms[i]
+		s = s[:m.Offset] + m.To + s[m.Offset+len(m.From):]
+	}
+	return s
+}
+
+func Test_MutateString(t *testing.T) {
+	require, assert := Describe(t)
+
+	g := Gen(t)
+	const s = "Hello World-1.0 café"
+	for _, tc := range []struct {
+		name string
+		m    StringMutator
+		ok   func(r rune) bool // nil for the homoglyphs, checked against their table.
+	}{
+		{"case", CaseMutator(), unicode.IsLetter},
+		{"homoglyph", HomoglyphMutator(), nil},
+		{"full-width", FullWidthMutator(), func(r rune) bool { return r >= 0xff01 && r <= 0xff5e || r == 0x3000 }},
+		{"whitespace", WhitespaceMutator(), unicode.IsSpace},
+		{"zero-width", ZeroWidthMutator(), func(r rune) bool { return unicode.Is(unicode.Cf, r) }},
+		{"normalization", NormalizationMutator(), func(r rune) bool { return r == 0x301 }},
+	} {
+		for i := 0; i < loops; i++ {
+			out, ms := g.MutateString(s, tc.m)
+			require.NotEmpty(ms, tc.name)
+			assert.NotEqual(s, out, tc.name)
+			assert.True(utf8.ValidString(out))
+			assert.Equal(out, replay(s, ms), ms.String())
+			for _, m := range ms {
+				assert.Equal(tc.name, m.Kind)
+				assert.Equal(m.From, s[m.Offset:m.Offset+len(m.From)])
+				if tc.ok == nil {
+					assert.Contains(homoglyphs[rune(m.From[0])], m.To, m.String())
+					continue
+				}
+				found := false
+				for _, r := range m.To {
+					found = found || tc.ok(r)
+				}
+				assert.True(found, m.String())
+			}
+		}
+	}
+	out, ms := g.MutateString(s, CaseMutator(), ZeroWidthMutator())
+	assert.NotEqual(s, out)
+	assert.Contains(ms.String(), "case at byte")
+	assert.Contains(ms.String(), "zero-width at byte")
+	_, ms = g.MutateString(s)
+	assert.NotEmpty(ms)
+	out, ms = g.MutateString("123", CaseMutator())
+	assert.Equal("123", out)
+	assert.Empty(ms)
+}
+
+func Test_NormalizationMutator(t *testing.T) {
+	_, assert := Describe(t)
+
+	g := Gen(t)
+	const nfc = "Ça déçoit Łódź? Šárka, Øre, Ärger"
+	for i := 0; i < loops; i++ {
+		nfd, ms := g.MutateString(nfc, NormalizationMutator())
+		assert.Equal(utf8.RuneCountInString(nfc)+len(ms), utf8.RuneCountInString(nfd))
+		for _, m := range ms {
+			to := []rune(m.To)
+			assert.Len(to, 2)
+			assert.True(unicode.Is(unicode.Mn, to[1]), m.String())
+		}
+		back, ms2 := g.MutateString(nfd, NormalizationMutator())
+		assert.NotEmpty(ms2)
+		assert.NotEqual(nfd, back)
+	}
+	// "Ł" and "Ø" have no canonical decomposition.
+	out, ms := g.MutateString("Łø", NormalizationMutator())
+	assert.Equal("Łø", out)
+	assert.Empty(ms)
+	out, _ = g.MutateString("e\u0301te\u0301", NormalizationMutator())
+	assert.Contains([]string{"éte\u0301", "e\u0301té", "été"}, out)
+}
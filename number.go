@@ -0,0 +1,273 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"math"
+	"sort"
+)
+
+// NumberOption allows to parameterize the Random number functions such as RandomInt64 or
+// RandomFloat64.
+type NumberOption func(opts *numberOptions)
+
+type numberOptions struct {
+	edges     float64 // probability of an edge value.
+	nonFinite bool    // true if NaN and the infinities are edge values.
+}
+
+// WithEdgeValues sets the probability of an edge value within the range: the bounds of the range
+// and their neighbors, 0, 1, -1, the minimum and maximum of each integer width and their
+// neighbors.  For the floats, they also include -0, the subnormals and the extremes of float32 and
+// float64.  The default is 0.
+func WithEdgeValues(rate float64) NumberOption {
+	return func(no *numberOptions) {
+		no.edges = rate
+	}
+}
+
+// WithNonFinite adds NaN, +Inf and -Inf to the edge values of the floats, whatever the range.
+func WithNonFinite() NumberOption {
+	return func(no *numberOptions) {
+		no.nonFinite = true
+	}
+}
+
+func collectNumberOptions(options ...NumberOption) *numberOptions {
+	opts := &numberOptions{}
+	for _, option := range options {
+		option(opts)
+	}
+	return opts
+}
+
+// intEdges lists the signed integer edge values.
+var intEdges = []int64{
+	0, 1, -1, 2, -2,
+	math.MinInt8, math.MinInt8 - 1, math.MaxInt8, math.MaxInt8 + 1, math.MaxUint8, math.MaxUint8 + 1,
+	math.MinInt16, math.MinInt16 - 1, math.MaxInt16, math.MaxInt16 + 1, math.MaxUint16, math.MaxUint16 + 1,
+	math.MinInt32, math.MinInt32 - 1, math.MaxInt32, math.MaxInt32 + 1, math.MaxUint32, math.MaxUint32 + 1,
+	math.MinInt64, math.MinInt64 + 1, math.MaxInt64, math.MaxInt64 - 1,
+}
+
+// uintEdges lists the unsigned integer edge values not in intEdges.
+var uintEdges = []uint64{math.MaxInt64 + 1, math.MaxUint64 - 1, math.MaxUint64}
+
+// floatEdges lists the finite float edge values.
+var floatEdges = []float64{
+	0, math.Copysign(0, -1), 1, -1, 0.5, -0.5,
+	math.SmallestNonzeroFloat64, -math.SmallestNonzeroFloat64,
+	math.Float64frombits(0x000fffffffffffff), -math.Float64frombits(0x000fffffffffffff), // largest subnormals.
+	0x1p-1022, -0x1p-1022, // smallest normals.
+	math.MaxFloat64, -math.MaxFloat64, math.Nextafter(math.MaxFloat64, 0),
+	math.SmallestNonzeroFloat32, math.MaxFloat32, -math.MaxFloat32,
+	1 << 53, 1<<53 + 2, -(1 << 53), // beyond 2^53, the floats skip integers.
+	math.MaxInt64, math.MinInt64, math.Nextafter(1, 2), math.Nextafter(1, 0),
+}
+
+// RandomInt returns a random int in the range `minimum` to `maximum` included.
+func RandomInt(minimum int, maximum int, opts ...NumberOption) int {
+	return Default().RandomInt(minimum, maximum, opts...)
+}
+
+// RandomInt returns a random int.  See RandomInt.
+func (g *Generator) RandomInt(minimum int, maximum int, opts ...NumberOption) int {
+	return int(g.RandomInt64(int64(minimum), int64(maximum), opts...))
+}
+
+// RandomInt32 returns a random int32 in the range `minimum` to `maximum` included.
+func RandomInt32(minimum int32, maximum int32, opts ...NumberOption) int32 {
+	return Default().RandomInt32(minimum, maximum, opts...)
+}
+
+// RandomInt32 returns a random int32.  See RandomInt32.
+func (g *Generator) RandomInt32(minimum int32, maximum int32, opts ...NumberOption) int32 {
+	return int32(g.RandomInt64(int64(minimum), int64(maximum), opts...))
+}
+
+// RandomInt64 returns a random int64 in the range `minimum` to `maximum` included.  If `maximum` is
+// lower than `minimum`, it returns `minimum`.
+func RandomInt64(minimum int64, maximum int64, opts ...NumberOption) int64 {
+	return Default().RandomInt64(minimum, maximum, opts...)
+}
+
+// RandomInt64 returns a random int64.  See RandomInt64.
+func (g *Generator) RandomInt64(minimum int64, maximum int64, opts ...NumberOption) int64 {
+	no := collectNumberOptions(opts...)
+	if no.edges > 0 && maximum > minimum && g.Float64() < no.edges {
+		edges := []int64{minimum, minimum + 1, maximum, maximum - 1}
+		for _, e := range intEdges {
+			if e >= minimum && e <= maximum {
+				edges = append(edges, e)
+			}
+		}
+		return edges[g.IntN(len(edges))]
+	}
+	return g.int64Between(minimum, maximum)
+}
+
+// RandomUint32 returns a random uint32 in the range `minimum` to `maximum` included.
+func RandomUint32(minimum uint32, maximum uint32, opts ...NumberOption) uint32 {
+	return Default().RandomUint32(minimum, maximum, opts...)
+}
+
+// RandomUint32 returns a random uint32.  See RandomUint32.
+func (g *Generator) RandomUint32(minimum uint32, maximum uint32, opts ...NumberOption) uint32 {
+	return uint32(g.RandomUint64(uint64(minimum), uint64(maximum), opts...))
+}
+
+// RandomUint64 returns a random uint64 in the range `minimum` to `maximum` included.  If `maximum`
+// is lower than `minimum`, it returns `minimum`.
+func RandomUint64(minimum uint64, maximum uint64, opts ...NumberOption) uint64 {
+	return Default().RandomUint64(minimum, maximum, opts...)
+}
+
+// RandomUint64 returns a random uint64.  See RandomUint64.
+func (g *Generator) RandomUint64(minimum uint64, maximum uint64, opts ...NumberOption) uint64 {
+	no := collectNumberOptions(opts...)
+	if maximum <= minimum {
+		return minimum
+	}
+	if no.edges > 0 && g.Float64() < no.edges {
+		edges := []uint64{minimum, minimum + 1, maximum, maximum - 1}
+		for _, e := range intEdges {
+			if e >= 0 && uint64(e) >= minimum && uint64(e) <= maximum {
+				edges = append(edges, uint64(e))
+			}
+		}
+		for _, e := range uintEdges {
+			if e >= minimum && e <= maximum {
+				edges = append(edges, e)
+			}
+		}
+		return edges[g.IntN(len(edges))]
+	}
+	if maximum-minimum == math.MaxUint64 {
+		return g.Uint64()
+	}
+	return minimum + g.Uint64N(maximum-minimum+1)
+}
+
+// RandomFloat32 returns a random float32 in the range `minimum` to `maximum` included.  If `maximum`
+// is not greater than `minimum`, it returns `minimum`.
+func RandomFloat32(minimum float32, maximum float32, opts ...NumberOption) float32 {
+	return Default().RandomFloat32(minimum, maximum, opts...)
+}
+
+// RandomFloat32 returns a random float32.  See RandomFloat32.
+func (g *Generator) RandomFloat32(minimum float32, maximum float32, opts ...NumberOption) float32 {
+	if !(maximum > minimum) {
+		return minimum
+	}
+	for {
+		x := g.RandomFloat64(float64(minimum), float64(maximum), opts...)
+		// the rounding to float32 may leave the range or overflow.
+		f := float32(x)
+		if math.IsNaN(x) || (f >= minimum && f <= maximum && math.IsInf(float64(f), 0) == math.IsInf(x, 0)) {
+			return f
+		}
+	}
+}
+
+// RandomFloat64 returns a random float64 in the range `minimum` to `maximum` included.  With an
+// infinite bound, the bits of the float are random so that all the magnitudes are likely.
+func RandomFloat64(minimum float64, maximum float64, opts ...NumberOption) float64 {
+	return Default().RandomFloat64(minimum, maximum, opts...)
+}
+
+// RandomFloat64 returns a random float64.  See RandomFloat64.
+func (g *Generator) RandomFloat64(minimum float64, maximum float64, opts ...NumberOption) float64 {
+	no := collectNumberOptions(opts...)
+	if no.edges > 0 && g.Float64() < no.edges {
+		var edges []float64
+		if no.nonFinite {
+			edges = append(edges, math.NaN(), math.Inf(1), math.Inf(-1))
+		}
+		for _, e := range append([]float64{minimum, maximum}, floatEdges...) {
+			if e >= minimum && e <= maximum {
+				edges = append(edges, e)
+			}
+		}
+		if len(edges) != 0 {
+			return edges[g.IntN(len(edges))]
+		}
+	}
+	if !(maximum > minimum) {
+		return minimum
+	}
+	if math.IsInf(minimum, 0) || math.IsInf(maximum, 0) {
+		for {
+			x := math.Float64frombits(g.Uint64())
+			if !math.IsNaN(x) && x >= minimum && x <= maximum {
+				return x
+			}
+		}
+	}
+	// this interpolation does not overflow, unlike minimum + u*(maximum-minimum).
+	u := g.Float64()
+	x := minimum*(1-u) + maximum*u
+	return math.Max(minimum, math.Min(maximum, x))
+}
+
+// RandomNormal returns a random number with a normal distribution of mean `mean` and standard
+// deviation `stddev`.
+func RandomNormal(mean float64, stddev float64) float64 {
+	return Default().RandomNormal(mean, stddev)
+}
+
+// RandomNormal returns a normally distributed random number.  See RandomNormal.
+func (g *Generator) RandomNormal(mean float64, stddev float64) float64 {
+	return mean + stddev*g.NormFloat64()
+}
+
+// RandomExponential returns a random number with an exponential distribution of rate `rate`, i.e.,
+// of mean 1/rate.  It models, for instance, the delay between independent events.
+func RandomExponential(rate float64) float64 {
+	return Default().RandomExponential(rate)
+}
+
+// RandomExponential returns an exponentially distributed random number.  See RandomExponential.
+func (g *Generator) RandomExponential(rate float64) float64 {
+	return g.ExpFloat64() / rate
+}
+
+// RandomPareto returns a random number with a Pareto distribution of scale, i.e., minimum,
+// `scale` and shape `shape`.  It models heavy-tailed quantities such as the size of files.
+func RandomPareto(scale float64, shape float64) float64 {
+	return Default().RandomPareto(scale, shape)
+}
+
+// RandomPareto returns a random number with a Pareto distribution.  See RandomPareto.
+func (g *Generator) RandomPareto(scale float64, shape float64) float64 {
+	// 1 - Float64() is in ]0, 1].
+	return scale / math.Pow(1-g.Float64(), 1/shape)
+}
+
+// NewZipfSampler returns a function drawing ranks in the range 0 to `n`-1 with a Zipf
+// distribution of exponent `s`: the probability of the rank k is proportional to 1/(k+1)^s.  With
+// `s` about 1, it models the access to hot keys.
+func NewZipfSampler(s float64, n int) func() int {
+	return Default().NewZipfSampler(s, n)
+}
+
+// NewZipfSampler returns a function drawing ranks with a Zipf distribution.  See NewZipfSampler.
+func (g *Generator) NewZipfSampler(s float64, n int) func() int {
+	if n <= 0 {
+		return func() int { return 0 }
+	}
+	cumul := make([]float64, n)
+	total := 0.0
+	for k := range cumul {
+		total += math.Pow(float64(k+1), -s)
+		cumul[k] = total
+	}
+	return func() int {
+		i := sort.SearchFloat64s(cumul, g.Float64()*total)
+		if i >= n {
+			return n - 1
+		}
+		return i
+	}
+}
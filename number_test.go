@@ -0,0 +1,170 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"math"
+	"testing"
+)
+
+func Test_RandomInt64(t *testing.T) {
+	_, assert := Describe(t)
+
+	g := Gen(t)
+	seen := make(map[int64]bool)
+	for i := 0; i < loops; i++ {
+		n := g.RandomInt64(-10, 10)
+		assert.True(n >= -10 && n <= 10)
+		seen[n] = true
+		assert.Equal(int64(5), g.RandomInt64(5, 5))
+		assert.Equal(int64(5), g.RandomInt64(5, 4))
+		_ = g.RandomInt64(math.MinInt64, math.MaxInt64)
+	}
+	assert.Len(seen, 21)
+
+	edges := make(map[int64]bool)
+	for i := 0; i < 10*loops; i++ {
+		n := g.RandomInt64(math.MinInt64, math.MaxInt64, WithEdgeValues(1))
+		edges[n] = true
+		m := g.RandomInt64(-1000, 1000, WithEdgeValues(1))
+		assert.True(m >= -1000 && m <= 1000)
+	}
+	for _, e := range []int64{0, -1, math.MaxInt8, math.MinInt8, math.MaxInt32 + 1, math.MinInt64, math.MaxInt64} {
+		assert.True(edges[e], e)
+	}
+}
+
+func Test_RandomInt(t *testing.T) {
+	_, assert := Describe(t)
+
+	g := Gen(t)
+	for i := 0; i < loops; i++ {
+		n := g.RandomInt(1, 6, WithEdgeValues(0.5))
+		assert.True(n >= 1 && n <= 6)
+		m := RandomInt32(math.MinInt32, math.MaxInt32, WithEdgeValues(0.5))
+		assert.True(m >= math.MinInt32 && m <= math.MaxInt32)
+	}
+}
+
+func Test_RandomUint64(t *testing.T) {
+	_, assert := Describe(t)
+
+	g := Gen(t)
+	edges := make(map[uint64]bool)
+	for i := 0; i < 10*loops; i++ {
+		n := g.RandomUint64(10, 20)
+		assert.True(n >= 10 && n <= 20)
+		edges[g.RandomUint64(0, math.MaxUint64, WithEdgeValues(1))] = true
+		u := RandomUint32(100, math.MaxUint32, WithEdgeValues(0.5))
+		assert.True(u >= 100)
+	}
+	for _, e := range []uint64{0, 1, math.MaxUint8, math.MaxUint32 + 1, math.MaxInt64 + 1, math.MaxUint64} {
+		assert.True(edges[e], e)
+	}
+	assert.Equal(uint64(3), g.RandomUint64(3, 1))
+}
+
+func Test_RandomFloat64(t *testing.T) {
+	_, assert := Describe(t)
+
+	g := Gen(t)
+	for i := 0; i < loops; i++ {
+		x := g.RandomFloat64(-1, 1)
+		assert.True(x >= -1 && x <= 1)
+		y := g.RandomFloat64(-math.MaxFloat64, math.MaxFloat64)
+		assert.False(math.IsInf(y, 0) || math.IsNaN(y))
+		z := g.RandomFloat64(0, math.Inf(1))
+		assert.True(z >= 0)
+		f := g.RandomFloat32(-1, 1, WithEdgeValues(0.5))
+		assert.True(f >= -1 && f <= 1)
+		f = g.RandomFloat32(float32(math.Inf(-1)), float32(math.Inf(1)))
+		assert.False(math.IsNaN(float64(f)))
+	}
+
+	var nan, inf, negZero, subnormal bool
+	for i := 0; i < 10*loops; i++ {
+		x := g.RandomFloat64(math.Inf(-1), math.Inf(1), WithEdgeValues(1), WithNonFinite())
+		switch {
+		case math.IsNaN(x):
+			nan = true
+		case math.IsInf(x, 0):
+			inf = true
+		case x == 0 && math.Signbit(x):
+			negZero = true
+		case x != 0 && math.Abs(x) < 0x1p-1022:
+			subnormal = true
+		}
+		y := g.RandomFloat64(1, 2, WithEdgeValues(1))
+		assert.True(y >= 1 && y <= 2, y)
+	}
+	assert.True(nan && inf && negZero && subnormal)
+	assert.Equal(2.0, g.RandomFloat64(2, 1))
+	assert.Equal(float32(2), g.RandomFloat32(2, 1))
+	assert.Equal(float32(2), g.RandomFloat32(2, 1, WithEdgeValues(1)))
+}
+
+func Test_RandomNormal(t *testing.T) {
+	_, assert := Describe(t)
+
+	g := Gen(t)
+	const n = 10000
+	sum, sum2 := 0.0, 0.0
+	for i := 0; i < n; i++ {
+		x := g.RandomNormal(10, 2)
+		sum += x
+		sum2 += x * x
+	}
+	mean := sum / n
+	assert.InDelta(10, mean, 0.1)
+	assert.InDelta(2, math.Sqrt(sum2/n-mean*mean), 0.1)
+}
+
+func Test_RandomExponential(t *testing.T) {
+	_, assert := Describe(t)
+
+	g := Gen(t)
+	const n = 10000
+	sum := 0.0
+	for i := 0; i < n; i++ {
+		x := g.RandomExponential(4)
+		assert.True(x >= 0)
+		sum += x
+	}
+	assert.InDelta(0.25, sum/n, 0.02)
+}
+
+func Test_RandomPareto(t *testing.T) {
+	_, assert := Describe(t)
+
+	g := Gen(t)
+	const n = 10000
+	above := 0
+	for i := 0; i < n; i++ {
+		x := g.RandomPareto(1, 2)
+		assert.True(x >= 1)
+		if x > 2 {
+			above++
+		}
+	}
+	// P(X > 2) = (1/2)^2.
+	assert.InDelta(0.25, float64(above)/n, 0.02)
+}
+
+func Test_NewZipfSampler(t *testing.T) {
+	_, assert := Describe(t)
+
+	next := Gen(t).NewZipfSampler(1, 100)
+	const n = 20000
+	counts := make([]int, 100)
+	for i := 0; i < n; i++ {
+		k := next()
+		assert.True(k >= 0 && k < 100)
+		counts[k]++
+	}
+	// the rank 0 is twice as frequent as the rank 1, and ten times as the rank 9.
+	assert.InEpsilon(2, float64(counts[0])/float64(counts[1]), 0.15)
+	assert.InEpsilon(10, float64(counts[0])/float64(counts[9]), 0.3)
+	assert.Equal(0, NewZipfSampler(1, 0)())
+}
@@ -0,0 +1,158 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import "fmt"
+
+// PayloadCategory is the attack class of a Payload.
+type PayloadCategory int
+
+const (
+	// SQLInjection payloads alter SQL queries built by concatenation.
+	SQLInjection PayloadCategory = iota
+	// XSS payloads inject scripts in HTML pages.
+	XSS
+	// PathTraversal payloads escape a base directory.
+	PathTraversal
+	// FormatString payloads hold the verbs of format functions and template engines.
+	FormatString
+	// CRLFInjection payloads inject line breaks in headers and logs.
+	CRLFInjection
+	// NullByte payloads hold NUL characters, raw or encoded, which truncate strings in some layers.
+	NullByte
+	// OverlongUTF8 payloads encode ASCII characters such as "/" or "." in too many bytes.  They are
+	// invalid UTF-8 that lax decoders accept, bypassing filters.
+	OverlongUTF8
+
+	numPayloadCategories
+)
+
+var payloadCategoryNames = []string{"sql-injection", "xss", "path-traversal", "format-string", "crlf-injection",
+	"null-byte", "overlong-utf8"}
+
+// String returns the name of the category.
+func (c PayloadCategory) String() string {
+	if c < 0 || c >= numPayloadCategories {
+		return fmt.Sprintf("category(%d)", int(c))
+	}
+	return payloadCategoryNames[c]
+}
+
+// Payload is a hostile input for the tests of input validation.
+type Payload struct {
+	Category PayloadCategory
+	// Name describes the technique, for instance "tautology".
+	Name  string
+	Value string
+}
+
+// String returns the category, the name and the quoted value of the payload, for the messages of the
+// assertions.
+func (p Payload) String() string {
+	return fmt.Sprintf("%s/%s: %+q", p.Category, p.Name, p.Value)
+}
+
+// payloads is the corpus, grouped by category.
+var payloads = []Payload{
+	{SQLInjection, "tautology", "' OR '1'='1"},
+	{SQLInjection, "tautology-comment", "' OR 1=1--"},
+	{SQLInjection, "comment", "admin'--"},
+	{SQLInjection, "stacked-query", "'; DROP TABLE users;--"},
+	{SQLInjection, "union", "1 UNION SELECT NULL,NULL,NULL--"},
+	{SQLInjection, "order-by", "1' ORDER BY 10--"},
+	{SQLInjection, "time-based", "1' AND SLEEP(5)--"},
+	{SQLInjection, "time-based-mssql", "1; WAITFOR DELAY '0:0:5'--"},
+	{SQLInjection, "double-quote", "\" OR \"\"=\""},
+	{SQLInjection, "backslash-escape", "\\' OR 1=1 #"},
+
+	{XSS, "script", "<script>alert(1)</script>"},
+	{XSS, "attribute-break", "\"><img src=x onerror=alert(1)>"},
+	{XSS, "svg-onload", "<svg/onload=alert(1)>"},
+	{XSS, "javascript-url", "javascript:alert(1)"},
+	{XSS, "iframe", "<iframe src=\"javascript:alert(1)\"></iframe>"},
+	{XSS, "js-string-break", "';alert(1);//"},
+	{XSS, "mixed-case", "<ScRiPt>alert(1)</sCrIpT>"},
+	{XSS, "url-encoded", "%3Cscript%3Ealert(1)%3C%2Fscript%3E"},
+	{XSS, "entity-encoded", "&lt;script&gt;alert(1)&lt;/script&gt;"},
+	{XSS, "template", "{{constructor.constructor('alert(1)')()}}"},
+
+	{PathTraversal, "dot-dot-slash", "../../../../etc/passwd"},
+	{PathTraversal, "dot-dot-backslash", "..\\..\\..\\windows\\win.ini"},
+	{PathTraversal, "absolute", "/etc/passwd"},
+	{PathTraversal, "windows-absolute", "C:\\Windows\\System32\\drivers\\etc\\hosts"},
+	{PathTraversal, "url-encoded", "%2e%2e%2f%2e%2e%2fetc%2fpasswd"},
+	{PathTraversal, "double-encoded", "..%252f..%252fetc%252fpasswd"},
+	{PathTraversal, "nested", "....//....//etc/passwd"},
+	{PathTraversal, "file-url", "file:///etc/passwd"},
+	{PathTraversal, "unc", "\\\\attacker.example\\share\\file"},
+
+	{FormatString, "string-verbs", "%s%s%s%s%s"},
+	{FormatString, "hex-verbs", "%x%x%x%x"},
+	{FormatString, "pointer-verbs", "%p%p%p%p"},
+	{FormatString, "write-verb", "%n%n%n%n"},
+	{FormatString, "positional", "%1$s%2$s"},
+	{FormatString, "width", "%99999999d"},
+	{FormatString, "go-verbs", "%v%+v%#v%T"},
+	{FormatString, "python-format", "{0}{1}{__class__}"},
+	{FormatString, "expression-language", "${7*7}"},
+	{FormatString, "template", "{{.}}{{7*7}}"},
+
+	{CRLFInjection, "header", "value\r\nSet-Cookie: session=evil"},
+	{CRLFInjection, "response-splitting", "value\r\n\r\n<html>injected</html>"},
+	{CRLFInjection, "line-feed", "value\nInjected-Header: 1"},
+	{CRLFInjection, "carriage-return", "value\rInjected-Header: 1"},
+	{CRLFInjection, "url-encoded", "value%0d%0aLocation:%20http://attacker.example"},
+	{CRLFInjection, "log-forging", "user\n2026-01-01 00:00:00 INFO login succeeded for admin"},
+	{CRLFInjection, "unicode-line-separator", "value\u2028Injected-Header: 1"},
+
+	{NullByte, "raw", "\x00"},
+	{NullByte, "extension-truncation", "image.php\x00.png"},
+	{NullByte, "trailing", "admin\x00"},
+	{NullByte, "url-encoded", "image.php%00.png"},
+	{NullByte, "escaped", "admin\\0"},
+	{NullByte, "path", "../../etc/passwd\x00"},
+
+	{OverlongUTF8, "slash-2-bytes", "\xc0\xaf"},
+	{OverlongUTF8, "slash-3-bytes", "\xe0\x80\xaf"},
+	{OverlongUTF8, "slash-4-bytes", "\xf0\x80\x80\xaf"},
+	{OverlongUTF8, "dot-dot-slash", "\xc0\xae\xc0\xae\xc0\xaf"},
+	{OverlongUTF8, "nul", "\xc0\x80"},
+	{OverlongUTF8, "less-than", "\xc0\xbcscript\xc0\xbe"},
+	{OverlongUTF8, "apostrophe", "\xc0\xa7 OR 1=1--"},
+}
+
+// RandomPayload returns a random payload of the `categories`, or of any category if none.  It is the
+// hostile counterpart of RandomString.
+func RandomPayload(categories ...PayloadCategory) Payload {
+	return Default().RandomPayload(categories...)
+}
+
+// RandomPayload returns a random payload.  See RandomPayload.
+func (g *Generator) RandomPayload(categories ...PayloadCategory) Payload {
+	list := Payloads(categories...)
+	if len(list) == 0 {
+		return Payload{}
+	}
+	return list[g.IntN(len(list))]
+}
+
+// Payloads returns the payloads of the `categories`, or all of them if none, for instance to iterate
+// over them in a table test.
+func Payloads(categories ...PayloadCategory) []Payload {
+	if len(categories) == 0 {
+		return append([]Payload(nil), payloads...)
+	}
+	wanted := make(map[PayloadCategory]bool, len(categories))
+	for _, c := range categories {
+		wanted[c] = true
+	}
+	var list []Payload
+	for _, p := range payloads {
+		if wanted[p.Category] {
+			list = append(list, p)
+		}
+	}
+	return list
+}
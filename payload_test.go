@@ -0,0 +1,57 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func Test_Payloads(t *testing.T) {
+	require, assert := Describe(t)
+
+	all := Payloads()
+	names := map[string]bool{}
+	for c := PayloadCategory(0); c < numPayloadCategories; c++ {
+		list := Payloads(c)
+		require.NotEmpty(list, c.String())
+		for _, p := range list {
+			assert.Equal(c, p.Category)
+			assert.NotEmpty(p.Value)
+			assert.False(names[c.String()+p.Name], p.String())
+			names[c.String()+p.Name] = true
+			assert.True(strings.HasPrefix(p.String(), c.String()+"/"+p.Name+": "))
+		}
+	}
+	assert.Len(all, len(names))
+	assert.Len(Payloads(XSS, SQLInjection), len(Payloads(XSS))+len(Payloads(SQLInjection)))
+	all[0].Value = "changed"
+	assert.NotEqual("changed", Payloads()[0].Value)
+
+	for _, p := range Payloads(OverlongUTF8) {
+		assert.False(utf8.ValidString(p.Value), p.String())
+	}
+	for _, p := range Payloads(NullByte) {
+		assert.True(strings.Contains(p.Value, "\x00") || strings.Contains(p.Value, "0"), p.String())
+	}
+	assert.Equal("category(-1)", PayloadCategory(-1).String())
+	assert.Empty(Payloads(PayloadCategory(42)))
+}
+
+func Test_RandomPayload(t *testing.T) {
+	_, assert := Describe(t)
+
+	g := Gen(t)
+	seen := map[PayloadCategory]bool{}
+	for i := 0; i < loops; i++ {
+		seen[g.RandomPayload().Category] = true
+		p := g.RandomPayload(CRLFInjection, PathTraversal)
+		assert.Contains([]PayloadCategory{CRLFInjection, PathTraversal}, p.Category)
+	}
+	assert.Len(seen, int(numPayloadCategories))
+	assert.Equal(Payload{}, g.RandomPayload(PayloadCategory(42)))
+	assert.NotEmpty(RandomPayload(XSS).Value)
+}
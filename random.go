@@ -1,17 +1,17 @@
-// V0.10.0
+// V0.11.0
 // Author: Diehl E.
-// © Nov 2024
+// © Oct 2026
 
 package test
 
 import (
-	rand1 "crypto/rand"
 	"encoding/csv"
-	"math/rand/v2"
 	"os"
 	"path"
 	"path/filepath"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
 // AlphaNumType represents the kind of characters
@@ -37,12 +37,52 @@ const (
 	Caps
 	// Small requests only minor characters without space.
 	Small
+	// Hex requests only lower case hexadecimal digits.
+	Hex
+	// LDH requests the letters, digits and hyphen allowed in DNS labels.
+	LDH
+	// AText requests the characters allowed by RFC 5322 in the atoms of an email address.
+	AText
+	// Unreserved requests the characters that RFC 3986 does not need to percent-encode in URLs.
+	Unreserved
+	// MultiByte requests letters encoded on 2, 3 or 4 bytes in UTF-8 (Latin-1, Greek, Cyrillic,
+	// Deseret, CJK, Hangul, ...).
+	MultiByte
+	// Emoji requests emoji, including skin tone modifiers, flags and ZWJ sequences.
+	Emoji
+	// Combining requests Latin letters followed by one to three combining marks.
+	Combining
+	// RTL requests Hebrew and Arabic letters, digits and spaces.
+	RTL
+	// ZeroWidth requests Latin letters mixed with zero-width and invisible formatting characters.
+	ZeroWidth
+	// InvalidUTF8 requests Latin letters mixed with byte sequences that are not valid UTF-8.  The
+	// string always holds at least one invalid sequence.
+	InvalidUTF8
+	// Digits requests only decimal digits.
+	Digits
+	// Base32 requests the RFC 4648 base32 alphabet.
+	Base32
+	// Base64 requests the RFC 4648 base64 alphabet.
+	Base64
+
+	// endOfBuiltins is the first value available for the registered types.
+	endOfBuiltins
 )
 
-// RandomID returns a random 16-character, alphanumeric, ID.
-func RandomID() string {
-	const sizeID = 16
-	return RandomAlphaString(sizeID, AlphaNumNoSpace)
+// RandomID returns a random 16-character, alphanumeric, ID.  WithIDLength and WithIDAlphabet
+// change its length and its alphabet.  See UniqueIDs for IDs that never collide within a test.
+func RandomID(opts ...IDOption) string {
+	return Default().RandomID(opts...)
+}
+
+// RandomID returns a random ID.  See RandomID.
+func (g *Generator) RandomID(opts ...IDOption) string {
+	ido := collectIDOptions(opts...)
+	if ido.alphabet == nil {
+		return g.RandomAlphaString(ido.length, AlphaNumNoSpace)
+	}
+	return g.RandomCharsetString(ido.length, ido.alphabet)
 }
 
 // RandomName returns a random string with size characters.
@@ -54,20 +94,30 @@ func RandomID() string {
 // CAUTION: the randomness is not cryptographically secure, thus it should
 // not be used for generating passphrases.
 func RandomName(size int) string {
+	return Default().RandomName(size)
+}
 
-	return RandomAlphaString(size, AlphaNoSpace)
+// RandomName returns a random string with size characters.  See RandomName.
+func (g *Generator) RandomName(size int) string {
+
+	return g.RandomAlphaString(size, AlphaNoSpace)
 }
 
 // RandomSlice returns a random slice with size bytes.
 // If size is zero or negative, then the number of bytes in the slice is random in the range
 // 1 to 256 characters.
 func RandomSlice(size int) []byte {
+	return Default().RandomSlice(size)
+}
+
+// RandomSlice returns a random slice with size bytes.  See RandomSlice.
+func (g *Generator) RandomSlice(size int) []byte {
 	const size0 = 256 // max number of bytes for random set.
 	if size <= 0 {
-		size = rand.IntN(size0) + 1
+		size = g.IntN(size0) + 1
 	}
 	buffer := make([]byte, size)
-	_, _ = rand1.Read(buffer)
+	_, _ = g.Read(buffer)
 	return buffer
 }
 
@@ -80,51 +130,56 @@ func RandomSlice(size int) []byte {
 // CAUTION: the randomness is not cryptographically secure, thus it should
 // not be used for generating keys.
 func RandomString(size int) string {
+	return Default().RandomString(size)
+}
 
-	return RandomAlphaString(size, All)
+// RandomString returns a random string with size characters.  See RandomString.
+func (g *Generator) RandomString(size int) string {
+
+	return g.RandomAlphaString(size, All)
 }
 
 // RandomAlphaString generates a size-character random string which character
 // set depends on the value of t.  if t is not a proper value, the returned value
 // is the empty string.
 // If size is zero or negative, then the length of the string is random in the range
-// 1 to 256 characters.
+// 1 to 256 characters.  For the Unicode character sets, such as MultiByte or Emoji, size counts
+// runes.  Use RandomAlphaStringSized to count bytes.
+//
+// Custom character sets are registered with RegisterAlphaNumType.
 //
 // CAUTION: the randomness is not cryptographically secure, thus it should
 // not be used for generating keys.
 func RandomAlphaString(size int, t AlphaNumType) string {
+	return Default().RandomAlphaString(size, t)
+}
+
+// RandomAlphaString generates a size-character random string which character
+// set depends on the value of t.  See RandomAlphaString.
+func (g *Generator) RandomAlphaString(size int, t AlphaNumType) string {
 	const size0 = 256 // max number of bytes for random set.
-	conv := map[AlphaNumType][]byte{
-		All:             []byte("ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz01234567890 @.!$&_+-:;*?#/\\,()[]{}<>%\""),
-		AllCVS:          []byte("ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz01234567890 @.!$&_+-:*?#/\\()[]{}<>%\""),
-		AlphaNum:        []byte("ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz01234567890 "),
-		AlphaNumNoSpace: []byte("ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz01234567890"),
-		Alpha:           []byte("ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz "),
-		AlphaNoSpace:    []byte("ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"),
-		Caps:            []byte("ABCDEFGHIJKLMNOPQRSTUVWXYZ"),
-		Small:           []byte("abcdefghijklmnopqrstuvwxyz"),
-	}
 	if size <= 0 {
-		size = rand.IntN(size0) + 1
+		size = g.IntN(size0) + 1
+	}
+	if _, ok := unicodeSets[t]; ok {
+		return g.unicodeString(size, Runes, t)
 	}
-	var buffer []byte
-	choice, ok := conv[t]
+	c, ok := CharsetOf(t)
 	if !ok {
 		return ""
 	}
-	choiceSize := len(choice)
-	for i := 0; i < size; i++ {
-		// generates the characters
-		s := rand.IntN(choiceSize)
-		buffer = append(buffer, choice[s])
-	}
-	return string(buffer)
+	return g.RandomCharsetString(size, c)
 }
 
 // RandomCSVFile generates a file `name` that is a CSV table
 // of `columns` x `rows` using as separator `sep` with
 // random size fields.
 func RandomCSVFile(name string, columns int, rows int, sep rune) error {
+	return Default().RandomCSVFile(name, columns, rows, sep)
+}
+
+// RandomCSVFile generates a file `name` that is a CSV table.  See RandomCSVFile.
+func (g *Generator) RandomCSVFile(name string, columns int, rows int, sep rune) error {
 	name = setExtension(name, "csv")
 	f, err := os.Create(name)
 	if err != nil {
@@ -137,7 +192,7 @@ func RandomCSVFile(name string, columns int, rows int, sep rune) error {
 		var rec []string
 		for j := 0; j < columns; j++ {
 			// uses a complete character set without potential delimiters
-			rec = append(rec, RandomAlphaString(0, AllCVS))
+			rec = append(rec, g.RandomAlphaString(0, AllCVS))
 		}
 		err = wr.Write(rec)
 		if err != nil {
@@ -155,8 +210,13 @@ func RandomCSVFile(name string, columns int, rows int, sep rune) error {
 //
 // It returns the name of the generated file (without) the path.
 func RandomFileWithDir(size int, ext string, path string) (string, error) {
+	return Default().RandomFileWithDir(size, ext, path)
+}
+
+// RandomFileWithDir generates a random binary file of `size` K bytes.  See RandomFileWithDir.
+func (g *Generator) RandomFileWithDir(size int, ext string, path string) (string, error) {
 	const sizeOfSlices = 1024
-	name := setExtension(RandomID(), ext)
+	name := setExtension(g.RandomID(), ext)
 	if path != "" {
 		name = filepath.Join(path, name)
 	}
@@ -168,7 +228,7 @@ func RandomFileWithDir(size int, ext string, path string) (string, error) {
 
 	p := make([]byte, sizeOfSlices)
 	for i := 0; i < size; i++ {
-		_, err = rand1.Read(p)
+		_, err = g.Read(p)
 		if err != nil {
 			return "", err
 		}
@@ -180,16 +240,30 @@ func RandomFileWithDir(size int, ext string, path string) (string, error) {
 	return filepath.Base(f.Name()), nil
 }
 
-// SwapCase randomly changes each character to upper or lower case
+// SwapCase randomly changes each character to upper or lower case.  It applies the Unicode case
+// mapping and keeps the invalid UTF-8 bytes untouched.
 func SwapCase(s string) string {
+	return Default().SwapCase(s)
+}
+
+// SwapCase randomly changes each character to upper or lower case
+func (g *Generator) SwapCase(s string) string {
 	const dice = 3
 	var sb strings.Builder
-	for _, r := range s {
-		switch rand.IntN(dice) { //nolint:gosec
+	for len(s) > 0 {
+		r, n := utf8.DecodeRuneInString(s)
+		if r == utf8.RuneError && n <= 1 {
+			// keeps the invalid UTF-8 bytes untouched.
+			sb.WriteString(s[:n])
+			s = s[n:]
+			continue
+		}
+		s = s[n:]
+		switch g.IntN(dice) { //nolint:gosec
 		case 0:
-			sb.WriteRune(toLower(r))
+			sb.WriteRune(unicode.ToLower(r))
 		case 1:
-			sb.WriteRune(toUpper(r))
+			sb.WriteRune(unicode.ToUpper(r))
 		case dice - 1:
 			sb.WriteRune(r)
 		}
@@ -227,19 +301,3 @@ func strip(name string, ext string) string {
 	}
 	return name
 }
-
-// toUpper converts a rune to upper case if it's a letter
-func toUpper(r rune) rune {
-	if r >= 'a' && r <= 'z' {
-		return r - 'a' + 'A'
-	}
-	return r
-}
-
-// toLower converts a rune to lower case if it's a letter
-func toLower(r rune) rune {
-	if r >= 'A' && r <= 'Z' {
-		return r - 'A' + 'a'
-	}
-	return r
-}
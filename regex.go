@@ -0,0 +1,136 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"errors"
+	"regexp"
+	"regexp/syntax"
+	"strings"
+	"unicode"
+)
+
+const (
+	// maxRepeat is the number of extra repetitions of the unbounded operators *, + and {n,}.
+	maxRepeat = 4
+	// printableFirst and printableLast bound the printable ASCII characters.
+	printableFirst, printableLast = 0x20, 0x7E
+)
+
+var (
+	// ErrNoMatch occurs when no random string matching a regular expression was found.
+	ErrNoMatch = errors.New("no matching string found")
+)
+
+// RandomMatching returns a random string that matches the regular expression `pattern`
+// (RE2 syntax).  The unbounded repetitions are limited to a few occurrences.  It returns an error
+// if the pattern is invalid or if no matching string was found, for instance because of
+// contradicting anchors.
+func RandomMatching(pattern string) (string, error) {
+	return Default().RandomMatching(pattern)
+}
+
+// RandomMatching returns a random string that matches the regular expression `pattern`.
+// See RandomMatching.
+func (g *Generator) RandomMatching(pattern string) (string, error) {
+	const tries = 16
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return "", err
+	}
+	check := regexp.MustCompile(pattern)
+	re = re.Simplify()
+	for i := 0; i < tries; i++ {
+		var sb strings.Builder
+		g.regex(&sb, re)
+		if s := sb.String(); check.MatchString(s) {
+			return s, nil
+		}
+	}
+	return "", ErrNoMatch
+}
+
+// regex writes to `sb` a random string matching `re`.
+func (g *Generator) regex(sb *strings.Builder, re *syntax.Regexp) {
+	switch re.Op {
+	case syntax.OpLiteral:
+		for _, r := range re.Rune {
+			if re.Flags&syntax.FoldCase != 0 && g.IntN(2) == 0 {
+				r = unicode.SimpleFold(r)
+			}
+			sb.WriteRune(r)
+		}
+	case syntax.OpCharClass:
+		sb.WriteRune(g.classRune(re.Rune))
+	case syntax.OpAnyCharNotNL, syntax.OpAnyChar:
+		sb.WriteRune(rune(printableFirst + g.IntN(printableLast-printableFirst+1)))
+	case syntax.OpCapture:
+		g.regex(sb, re.Sub[0])
+	case syntax.OpStar:
+		g.repeat(sb, re.Sub[0], 0, maxRepeat)
+	case syntax.OpPlus:
+		g.repeat(sb, re.Sub[0], 1, 1+maxRepeat)
+	case syntax.OpQuest:
+		g.repeat(sb, re.Sub[0], 0, 1)
+	case syntax.OpRepeat:
+		maximum := re.Max
+		if maximum < 0 {
+			maximum = re.Min + maxRepeat
+		}
+		g.repeat(sb, re.Sub[0], re.Min, maximum)
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			g.regex(sb, sub)
+		}
+	case syntax.OpAlternate:
+		g.regex(sb, re.Sub[g.IntN(len(re.Sub))])
+	default:
+		// the anchors, the word boundaries and the empty matches generate nothing.
+	}
+}
+
+// repeat writes between `minimum` and `maximum` strings matching `re`.
+func (g *Generator) repeat(sb *strings.Builder, re *syntax.Regexp, minimum int, maximum int) {
+	for n := g.between(minimum, maximum); n > 0; n-- {
+		g.regex(sb, re)
+	}
+}
+
+// classRune returns a random rune of the character class `ranges`, a list of pairs of bounds.
+// It prefers the printable ASCII characters of the class if any.
+func (g *Generator) classRune(ranges []rune) rune {
+	var printable []rune
+	for i := 0; i+1 < len(ranges); i += 2 {
+		lo, hi := ranges[i], ranges[i+1]
+		if lo < printableFirst {
+			lo = printableFirst
+		}
+		if hi > printableLast {
+			hi = printableLast
+		}
+		if lo <= hi {
+			printable = append(printable, lo, hi)
+		}
+	}
+	if len(printable) != 0 {
+		ranges = printable
+	}
+	if len(ranges) == 0 {
+		return unicode.ReplacementChar
+	}
+	total := 0
+	for i := 0; i+1 < len(ranges); i += 2 {
+		total += int(ranges[i+1]-ranges[i]) + 1
+	}
+	n := g.IntN(total)
+	for i := 0; i+1 < len(ranges); i += 2 {
+		size := int(ranges[i+1]-ranges[i]) + 1
+		if n < size {
+			return ranges[i] + rune(n)
+		}
+		n -= size
+	}
+	return ranges[0]
+}
@@ -0,0 +1,28 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"regexp"
+	"testing"
+)
+
+func Test_RandomMatching(t *testing.T) {
+	require, assert := Describe(t)
+
+	g := Gen(t)
+	for _, p := range []string{`^[A-Z]{3}-\d{4}$`, `(foo|bar)+baz?`, `^(?i)hello\s+world$`, `[^a-z]`, `a.b*c{2,}`, `\w+@\w+\.com`} {
+		re := regexp.MustCompile(p)
+		for i := 0; i < 50; i++ {
+			s, err := g.RandomMatching(p)
+			require.NoError(err)
+			assert.Regexp(re, s)
+		}
+	}
+	_, err := g.RandomMatching(`(`)
+	assert.Error(err)
+	_, err = g.RandomMatching(`a^b`)
+	assert.ErrorIs(err, ErrNoMatch)
+}
@@ -0,0 +1,174 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+var (
+	// ErrStreamMismatch occurs when the verified bytes differ from the random stream.
+	ErrStreamMismatch = errors.New("bytes differ from the random stream")
+	// ErrNegativeOffset occurs when seeking or reading at a negative offset.
+	ErrNegativeOffset = errors.New("negative offset")
+)
+
+// RandomReader is a virtual random stream of any size.  Each byte is computed from the seed and its
+// offset, thus the stream is never stored and any range can be read again, in any order.  It
+// implements the io.ReadSeeker and io.ReaderAt interfaces.  ReadAt is safe for concurrent use, but
+// Read and Seek share the current offset.
+type RandomReader struct {
+	seed uint64
+	size int64
+	off  int64
+}
+
+// NewRandomReader returns a RandomReader of `size` bytes whose stream is defined by `seed`.
+func NewRandomReader(seed uint64, size int64) *RandomReader {
+	return &RandomReader{seed: seed, size: size}
+}
+
+// NewRandomReader returns a RandomReader of `size` bytes with a seed drawn from the generator.
+// See NewRandomReader.
+func (g *Generator) NewRandomReader(size int64) *RandomReader {
+	return NewRandomReader(g.Uint64(), size)
+}
+
+// Seed returns the seed of the stream.
+func (rr *RandomReader) Seed() uint64 {
+	return rr.seed
+}
+
+// Size returns the size of the stream.
+func (rr *RandomReader) Size() int64 {
+	return rr.size
+}
+
+// Read reads the stream at the current offset.  It implements the io.Reader interface.
+func (rr *RandomReader) Read(p []byte) (int, error) {
+	n, err := rr.ReadAt(p, rr.off)
+	rr.off += int64(n)
+	if err == io.EOF && n != 0 {
+		err = nil
+	}
+	return n, err
+}
+
+// ReadAt reads the stream at `off`.  It implements the io.ReaderAt interface.
+func (rr *RandomReader) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, ErrNegativeOffset
+	}
+	if off >= rr.size {
+		return 0, io.EOF
+	}
+	n := len(p)
+	if int64(n) > rr.size-off {
+		n = int(rr.size - off)
+	}
+	rr.fill(p[:n], off)
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// Seek sets the offset of the next Read.  It implements the io.Seeker interface.  Seeking beyond
+// the end is allowed; the next Read then returns io.EOF.
+func (rr *RandomReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += rr.off
+	case io.SeekEnd:
+		offset += rr.size
+	default:
+		return rr.off, fmt.Errorf("invalid whence %d", whence)
+	}
+	if offset < 0 {
+		return rr.off, ErrNegativeOffset
+	}
+	rr.off = offset
+	return offset, nil
+}
+
+// Verify checks that `p` equals the bytes of the stream at `off`.  Otherwise, it returns an error
+// wrapping ErrStreamMismatch with the offset of the first differing byte.
+func (rr *RandomReader) Verify(off int64, p []byte) error {
+	const chunk = 4096
+	want := make([]byte, chunk)
+	for len(p) > 0 {
+		m := minInt(chunk, len(p))
+		n, _ := rr.ReadAt(want[:m], off)
+		for i := 0; i < n; i++ {
+			if p[i] != want[i] {
+				return fmt.Errorf("%w: offset %d holds 0x%02x instead of 0x%02x", ErrStreamMismatch, off+int64(i),
+					p[i], want[i])
+			}
+		}
+		if n < m {
+			return fmt.Errorf("%w: offset %d is beyond the end of the stream", ErrStreamMismatch, off+int64(n))
+		}
+		p, off = p[n:], off+int64(n)
+	}
+	return nil
+}
+
+// VerifyReader checks that the bytes read from `r` until io.EOF equal the stream from `off`.  It
+// returns the number of verified bytes and, on mismatch, an error wrapping ErrStreamMismatch.
+func (rr *RandomReader) VerifyReader(off int64, r io.Reader) (int64, error) {
+	const chunk = 32 * 1024
+	p := make([]byte, chunk)
+	var total int64
+	for {
+		n, err := r.Read(p)
+		if n > 0 {
+			if errV := rr.Verify(off+total, p[:n]); errV != nil {
+				return total, errV
+			}
+			total += int64(n)
+		}
+		if err == io.EOF {
+			return total, nil
+		}
+		if err != nil {
+			return total, err
+		}
+	}
+}
+
+// fill writes to `p` the bytes of the stream at `off`.  The stream is made of 64-bit little endian
+// words, the word of index i being the i-th output of SplitMix64 seeded with the seed.
+func (rr *RandomReader) fill(p []byte, off int64) {
+	var w [8]byte
+	index := uint64(off) / 8
+	skip := int(off % 8)
+	for len(p) > 0 {
+		binary.LittleEndian.PutUint64(w[:], splitMix64(rr.seed, index))
+		n := copy(p, w[skip:])
+		p, skip = p[n:], 0
+		index++
+	}
+}
+
+// splitMix64 returns the output of index `i` of SplitMix64 seeded with `seed`.  Unlike a sequential
+// generator, it jumps to any index.
+func splitMix64(seed uint64, i uint64) uint64 {
+	x := seed + (i+1)*0x9e3779b97f4a7c15
+	x = (x ^ x>>30) * 0xbf58476d1ce4e5b9
+	x = (x ^ x>>27) * 0x94d049bb133111eb
+	return x ^ x>>31
+}
+
+// minInt returns the minimum of `a` and `b`.
+func minInt(a int, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,99 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+)
+
+func Test_RandomReader(t *testing.T) {
+	require, assert := Describe(t)
+
+	g := Gen(t)
+	const size = 10000
+	rr := g.NewRandomReader(size)
+	assert.Equal(int64(size), rr.Size())
+	whole, err := io.ReadAll(rr)
+	require.NoError(err)
+	require.Len(whole, size)
+	for i := 0; i < loops; i++ {
+		off := int64(g.IntN(size + 10))
+		p := make([]byte, g.IntN(100))
+		n, err := rr.ReadAt(p, off)
+		if off+int64(len(p)) > size {
+			assert.Equal(io.EOF, err)
+		} else {
+			assert.NoError(err)
+		}
+		if off < size {
+			assert.Equal(whole[off:off+int64(n)], p[:n])
+		}
+		pos, err := rr.Seek(off, io.SeekStart)
+		require.NoError(err)
+		assert.Equal(off, pos)
+		n2, _ := io.ReadFull(rr, p)
+		assert.Equal(n, n2)
+	}
+	// the same seed gives the same stream, whatever the size.
+	other := NewRandomReader(rr.Seed(), 1<<40)
+	p := make([]byte, size)
+	_, err = other.ReadAt(p, 0)
+	require.NoError(err)
+	assert.Equal(whole, p)
+	_, err = NewRandomReader(rr.Seed()+1, size).ReadAt(p, 0)
+	require.NoError(err)
+	assert.NotEqual(whole, p)
+
+	pos, err := rr.Seek(-10, io.SeekEnd)
+	require.NoError(err)
+	assert.Equal(int64(size-10), pos)
+	pos, err = rr.Seek(5, io.SeekCurrent)
+	require.NoError(err)
+	assert.Equal(int64(size-5), pos)
+	_, err = rr.Seek(-1, io.SeekStart)
+	assert.ErrorIs(err, ErrNegativeOffset)
+	_, err = rr.Seek(0, 42)
+	assert.Error(err)
+	_, err = rr.ReadAt(p, -1)
+	assert.ErrorIs(err, ErrNegativeOffset)
+}
+
+func Test_RandomReader_Verify(t *testing.T) {
+	require, assert := Describe(t)
+
+	rr := Gen(t).NewRandomReader(1 << 20)
+	p := make([]byte, 10000)
+	_, err := rr.ReadAt(p, 12345)
+	require.NoError(err)
+	require.NoError(rr.Verify(12345, p))
+	assert.ErrorIs(rr.Verify(12344, p), ErrStreamMismatch)
+	p[9000] ^= 1
+	err = rr.Verify(12345, p)
+	assert.ErrorIs(err, ErrStreamMismatch)
+	assert.Contains(err.Error(), "offset 21345")
+	p[9000] ^= 1
+	assert.ErrorIs(rr.Verify(1<<20-100, p), ErrStreamMismatch)
+
+	// a large range read back from a copy of the stream.
+	_, err = rr.Seek(1000, io.SeekStart)
+	require.NoError(err)
+	var b bytes.Buffer
+	_, err = io.Copy(&b, rr)
+	require.NoError(err)
+	n, err := rr.VerifyReader(1000, &b)
+	require.NoError(err)
+	assert.Equal(int64(1<<20-1000), n)
+	n, err = rr.VerifyReader(0, io.MultiReader(io.NewSectionReader(rr, 0, 5000), bytes.NewReader([]byte{0, 0})))
+	if err == nil {
+		// the two zero bytes may match the stream by chance.
+		assert.Equal(int64(5002), n)
+	} else {
+		assert.ErrorIs(err, ErrStreamMismatch)
+	}
+	_, err = rr.VerifyReader(0, FaultyReader{})
+	assert.ErrorIs(err, ErrMock)
+}
@@ -0,0 +1,229 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// TextOption allows to parameterize RandomText.
+type TextOption func(opts *textOptions)
+
+type textOptions struct {
+	paragraphs   int
+	minSentences int
+	maxSentences int
+	minWords     int
+	maxWords     int
+	wordLengths  []int // weight of each word length, index 0 being length 1.
+	charset      AlphaNumType
+	vocabulary   []string // when not empty, the words are drawn from it.
+	punctuation  float64  // probability of a punctuation mark after a word.
+	width        int      // maximal number of runes per line.  0 means no wrapping.
+}
+
+// loremWords is the built-in lorem ipsum vocabulary.
+var loremWords = strings.Fields(`lorem ipsum dolor sit amet consectetur adipiscing elit sed do eiusmod
+	tempor incididunt ut labore et dolore magna aliqua enim ad minim veniam quis nostrud exercitation
+	ullamco laboris nisi aliquip ex ea commodo consequat duis aute irure in reprehenderit voluptate velit
+	esse cillum eu fugiat nulla pariatur excepteur sint occaecat cupidatat non proident sunt culpa qui
+	officia deserunt mollit anim id est laborum integer vitae justo eget magna fermentum iaculis nunc
+	pulvinar sapien pellentesque habitant morbi tristique senectus netus malesuada fames ac turpis egestas`)
+
+// englishWordLengths approximates the distribution of the word lengths in English, from 1 to 12.
+var englishWordLengths = []int{3, 17, 21, 16, 11, 9, 8, 6, 4, 3, 1, 1}
+
+// WithParagraphs sets the number of paragraphs.  The default is 1.  A negative number counts as 0.
+func WithParagraphs(n int) TextOption {
+	return func(to *textOptions) {
+		to.paragraphs = n
+	}
+}
+
+// WithSentences sets the range of the number of sentences per paragraph.  The default is 3 to 8.
+// A negative number counts as 0.
+func WithSentences(minimum int, maximum int) TextOption {
+	return func(to *textOptions) {
+		to.minSentences, to.maxSentences = minimum, maximum
+	}
+}
+
+// WithWords sets the range of the number of words per sentence.  The default is 4 to 16.  A negative
+// number counts as 0.  A sentence of no word is omitted.
+func WithWords(minimum int, maximum int) TextOption {
+	return func(to *textOptions) {
+		to.minWords, to.maxWords = minimum, maximum
+	}
+}
+
+// WithWordLengths sets the distribution of the length of the generated words.  `weights[i]` is the
+// relative frequency of the words of i+1 characters.  The default approximates English.
+// It is ignored when a vocabulary is used.
+func WithWordLengths(weights ...int) TextOption {
+	return func(to *textOptions) {
+		to.wordLengths = weights
+	}
+}
+
+// WithWordCharset sets the character set of the generated words.  The default is Small.
+// It is ignored when a vocabulary is used.
+func WithWordCharset(t AlphaNumType) TextOption {
+	return func(to *textOptions) {
+		to.charset = t
+	}
+}
+
+// WithLorem draws the words from the built-in lorem ipsum vocabulary.
+func WithLorem() TextOption {
+	return func(to *textOptions) {
+		to.vocabulary = loremWords
+	}
+}
+
+// WithVocabulary draws the words from `words`.  An empty or nil `words` keeps the random words.
+func WithVocabulary(words []string) TextOption {
+	return func(to *textOptions) {
+		to.vocabulary = words
+	}
+}
+
+// WithPunctuation sets the probability of a punctuation mark, such as a comma, after a word.
+// The default is 0.1.  With 0, the text has neither punctuation nor capital letters.
+func WithPunctuation(rate float64) TextOption {
+	return func(to *textOptions) {
+		to.punctuation = rate
+	}
+}
+
+// WithLineWidth wraps the lines at `width` runes.  A word longer than `width` stands alone on its line.
+func WithLineWidth(width int) TextOption {
+	return func(to *textOptions) {
+		to.width = width
+	}
+}
+
+func collectTextOptions(options ...TextOption) *textOptions {
+	opts := &textOptions{
+		paragraphs:   1,
+		minSentences: 3,
+		maxSentences: 8,
+		minWords:     4,
+		maxWords:     16,
+		wordLengths:  englishWordLengths,
+		charset:      Small,
+		punctuation:  0.1,
+	}
+	for _, option := range options {
+		option(opts)
+	}
+	for _, n := range []*int{&opts.paragraphs, &opts.minSentences, &opts.maxSentences, &opts.minWords, &opts.maxWords} {
+		if *n < 0 {
+			*n = 0
+		}
+	}
+	return opts
+}
+
+// RandomText returns a pseudo-natural text made of paragraphs of sentences of words.  The paragraphs
+// are separated by an empty line.  By default, it is one paragraph of random words; see the
+// TextOption functions for the other settings.
+func RandomText(opts ...TextOption) string {
+	return Default().RandomText(opts...)
+}
+
+// RandomText returns a pseudo-natural text.  See RandomText.
+func (g *Generator) RandomText(opts ...TextOption) string {
+	to := collectTextOptions(opts...)
+	paragraphs := make([]string, 0, to.paragraphs)
+	for i := 0; i < to.paragraphs; i++ {
+		n := g.between(to.minSentences, to.maxSentences)
+		sentences := make([]string, 0, n)
+		for j := 0; j < n; j++ {
+			if s := g.sentence(to); s != "" {
+				sentences = append(sentences, s)
+			}
+		}
+		p := strings.Join(sentences, " ")
+		if to.width > 0 {
+			p = wrap(p, to.width)
+		}
+		paragraphs = append(paragraphs, p)
+	}
+	return strings.Join(paragraphs, "\n\n")
+}
+
+// sentence returns a random sentence.
+func (g *Generator) sentence(to *textOptions) string {
+	n := g.between(to.minWords, to.maxWords)
+	words := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		w := g.word(to)
+		if to.punctuation > 0 && i < n-1 && g.Float64() < to.punctuation {
+			w += g.pickString([]string{",", ",", ",", ";", ":"})
+		}
+		words = append(words, w)
+	}
+	s := strings.Join(words, " ")
+	if to.punctuation <= 0 || s == "" {
+		return s
+	}
+	r, size := utf8.DecodeRuneInString(s)
+	return string(unicode.ToUpper(r)) + s[size:] + g.pickString([]string{".", ".", ".", ".", "?", "!"})
+}
+
+// word returns a random word.
+func (g *Generator) word(to *textOptions) string {
+	if len(to.vocabulary) != 0 {
+		return g.pickString(to.vocabulary)
+	}
+	total := 0
+	for _, w := range to.wordLengths {
+		total += w
+	}
+	if total <= 0 {
+		return g.RandomAlphaString(0, to.charset)
+	}
+	n := g.IntN(total)
+	length := 1
+	for i, w := range to.wordLengths {
+		if n < w {
+			length = i + 1
+			break
+		}
+		n -= w
+	}
+	return g.RandomAlphaString(length, to.charset)
+}
+
+// between returns a random number in the range `minimum` to `maximum` included.
+func (g *Generator) between(minimum int, maximum int) int {
+	if maximum <= minimum {
+		return minimum
+	}
+	return minimum + g.IntN(maximum-minimum+1)
+}
+
+// wrap breaks `s` into lines of at most `width` runes at the spaces.
+func wrap(s string, width int) string {
+	var sb strings.Builder
+	lineLen := 0
+	for _, w := range strings.Fields(s) {
+		l := utf8.RuneCountInString(w)
+		switch {
+		case lineLen == 0:
+		case lineLen+1+l > width:
+			sb.WriteByte('\n')
+			lineLen = 0
+		default:
+			sb.WriteByte(' ')
+			lineLen++
+		}
+		sb.WriteString(w)
+		lineLen += l
+	}
+	return sb.String()
+}
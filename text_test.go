@@ -0,0 +1,66 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func Test_RandomText(t *testing.T) {
+	_, assert := Describe(t)
+
+	g := Gen(t)
+	s := g.RandomText()
+	assert.NotEmpty(s)
+	assert.NotContains(s, "\n")
+
+	s = g.RandomText(WithParagraphs(3), WithSentences(2, 2), WithWords(5, 5), WithPunctuation(0))
+	paragraphs := strings.Split(s, "\n\n")
+	assert.Len(paragraphs, 3)
+	for _, p := range paragraphs {
+		assert.Len(strings.Fields(p), 10)
+		assert.Equal(strings.ToLower(p), p)
+	}
+
+	s = g.RandomText(WithWordLengths(0, 0, 1), WithPunctuation(0))
+	for _, w := range strings.Fields(s) {
+		assert.Len(w, 3)
+	}
+
+	s = g.RandomText(WithSentences(1, 1), WithPunctuation(1))
+	assert.True(strings.ContainsAny(s[len(s)-1:], ".?!"))
+	assert.True(strings.ContainsAny(s, ",;:"))
+	assert.Empty(g.RandomText(WithWords(0, 0), WithPunctuation(1)))
+	assert.Empty(g.RandomText(WithParagraphs(-1)))
+	assert.Empty(g.RandomText(WithSentences(-3, -1)))
+	assert.Empty(g.RandomText(WithWords(-5, -2)))
+}
+
+func Test_RandomText_Vocabulary(t *testing.T) {
+	_, assert := Describe(t)
+
+	g := Gen(t)
+	s := g.RandomText(WithLorem(), WithPunctuation(0))
+	for _, w := range strings.Fields(s) {
+		assert.Contains(loremWords, w)
+	}
+	s = g.RandomText(WithVocabulary([]string{"foo", "bar"}), WithPunctuation(0))
+	assert.Empty(strings.NewReplacer("foo", "", "bar", "", " ", "").Replace(s))
+	s = g.RandomText(WithVocabulary(nil), WithPunctuation(0))
+	assert.NotEmpty(s)
+}
+
+func Test_RandomText_LineWidth(t *testing.T) {
+	_, assert := Describe(t)
+
+	s := Gen(t).RandomText(WithParagraphs(2), WithLineWidth(40), WithWordCharset(MultiByte))
+	for _, l := range strings.Split(s, "\n") {
+		assert.LessOrEqual(utf8.RuneCountInString(l), 40)
+	}
+	assert.Equal("ab cd\nef", wrap("ab cd ef", 5))
+	assert.Equal("abcdefgh\nij", wrap("abcdefgh ij", 5))
+}
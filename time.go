@@ -0,0 +1,330 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// TimeFormat is a layout of timestamp generated by RandomTimestamp.
+type TimeFormat int
+
+const (
+	// FormatRFC3339 is the layout time.RFC3339.
+	FormatRFC3339 TimeFormat = iota
+	// FormatRFC3339Nano is the layout time.RFC3339Nano.
+	FormatRFC3339Nano
+	// FormatRFC1123 is the layout time.RFC1123.
+	FormatRFC1123
+	// FormatRFC1123Z is the layout time.RFC1123Z.
+	FormatRFC1123Z
+	// FormatRFC850 is the layout time.RFC850.
+	FormatRFC850
+	// FormatANSIC is the layout time.ANSIC.
+	FormatANSIC
+	// FormatDateTime is the layout "2006-01-02 15:04:05".
+	FormatDateTime
+	// FormatISODate is the layout "2006-01-02".
+	FormatISODate
+	// FormatISOWeek is the ISO 8601 week date, such as "2026-W42-5".
+	FormatISOWeek
+	// FormatUnix is the number of seconds since the Unix epoch.
+	FormatUnix
+	// FormatUnixMilli is the number of milliseconds since the Unix epoch.
+	FormatUnixMilli
+	endOfFormats
+)
+
+// timeLayouts holds the layouts of the TimeFormat values based on time.Format.
+var timeLayouts = map[TimeFormat]string{
+	FormatRFC3339:     time.RFC3339,
+	FormatRFC3339Nano: time.RFC3339Nano,
+	FormatRFC1123:     time.RFC1123,
+	FormatRFC1123Z:    time.RFC1123Z,
+	FormatRFC850:      time.RFC850,
+	FormatANSIC:       time.ANSIC,
+	FormatDateTime:    "2006-01-02 15:04:05",
+	FormatISODate:     "2006-01-02",
+}
+
+var (
+	// MinTime is the earliest instant that time.Time represents, i.e., its zero value.
+	MinTime = time.Time{}
+	// MaxTime is the latest instant that time.Time represents.
+	MaxTime = time.Unix(1<<63-1-unixToInternal, 999999999).UTC()
+)
+
+// unixToInternal is the number of seconds between the year 1 and the Unix epoch.
+const unixToInternal = 62135596800
+
+// InterestingZones lists IANA zones with daylight saving time, unusual offsets or extreme offsets.
+var InterestingZones = []string{
+	"UTC", "America/New_York", "America/Sao_Paulo", "Europe/Paris", "Europe/London", "Asia/Kolkata",
+	"Asia/Kathmandu", "Australia/Lord_Howe", "Pacific/Chatham", "Pacific/Kiritimati", "Pacific/Pago_Pago",
+}
+
+// leapSeconds lists the days ending with a leap second.
+var leapSeconds = []string{
+	"1972-06-30", "1972-12-31", "1973-12-31", "1974-12-31", "1975-12-31", "1976-12-31", "1977-12-31",
+	"1978-12-31", "1979-12-31", "1981-06-30", "1982-06-30", "1983-06-30", "1985-06-30", "1987-12-31",
+	"1989-12-31", "1990-12-31", "1992-06-30", "1993-06-30", "1994-06-30", "1995-12-31", "1997-06-30",
+	"1998-12-31", "2005-12-31", "2008-12-31", "2012-06-30", "2015-06-30", "2016-12-31",
+}
+
+// TimeOption allows to parameterize RandomTime and RandomTimestamp.
+type TimeOption func(opts *timeOptions)
+
+type timeOptions struct {
+	lo, hi  time.Time
+	zones   []*time.Location
+	dst     float64 // probability of an instant close to a DST transition.
+	edges   float64 // probability of an edge instant.
+	formats []TimeFormat
+}
+
+// WithTimeRange sets the range of the instants.  The default is 1970 to 2100.  If `maximum` is
+// before `minimum`, the instant is always `minimum`.
+func WithTimeRange(minimum time.Time, maximum time.Time) TimeOption {
+	return func(to *timeOptions) {
+		to.lo, to.hi = minimum, maximum
+	}
+}
+
+// WithZones sets the IANA names of the zones of the instants, for instance "Europe/Paris".  Without
+// name, it uses InterestingZones.  The unknown zones and those missing from the zone database of the
+// system are ignored; a main package may import time/tzdata to embed the database.  The default is
+// UTC.
+func WithZones(names ...string) TimeOption {
+	return func(to *timeOptions) {
+		if len(names) == 0 {
+			names = InterestingZones
+		}
+		to.zones = to.zones[:0]
+		for _, name := range names {
+			if loc, err := time.LoadLocation(name); err == nil {
+				to.zones = append(to.zones, loc)
+			}
+		}
+	}
+}
+
+// WithDSTBias sets the probability of an instant within two hours of a transition of daylight
+// saving time of the zone.  The default is 0.
+func WithDSTBias(rate float64) TimeOption {
+	return func(to *timeOptions) {
+		to.dst = rate
+	}
+}
+
+// WithTimeEdges sets the probability of an edge instant within the range: the bounds of the range,
+// the Unix epoch, the 32-bit overflow of 2038, leap days, leap seconds, the ends of year and the
+// extremes of time.Time.  The default is 0.
+func WithTimeEdges(rate float64) TimeOption {
+	return func(to *timeOptions) {
+		to.edges = rate
+	}
+}
+
+// WithFormats sets the formats used by RandomTimestamp.  The default is all the formats.
+func WithFormats(formats ...TimeFormat) TimeOption {
+	return func(to *timeOptions) {
+		to.formats = formats
+	}
+}
+
+func collectTimeOptions(options ...TimeOption) *timeOptions {
+	opts := &timeOptions{
+		lo:    time.Unix(0, 0).UTC(),
+		hi:    time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC),
+		zones: []*time.Location{time.UTC},
+	}
+	for _, option := range options {
+		option(opts)
+	}
+	if len(opts.zones) == 0 {
+		opts.zones = []*time.Location{time.UTC}
+	}
+	if len(opts.formats) == 0 {
+		for f := TimeFormat(0); f < endOfFormats; f++ {
+			opts.formats = append(opts.formats, f)
+		}
+	}
+	return opts
+}
+
+// RandomTime returns a random instant.  By default, it is uniform in 1970 to 2100 in UTC.  See the
+// TimeOption functions for the ranges, the zones and the bias toward edges and DST transitions.
+func RandomTime(opts ...TimeOption) time.Time {
+	return Default().RandomTime(opts...)
+}
+
+// RandomTime returns a random instant.  See RandomTime.
+func (g *Generator) RandomTime(opts ...TimeOption) time.Time {
+	to := collectTimeOptions(opts...)
+	t, _ := g.instant(to)
+	return t
+}
+
+// RandomDuration returns a random duration in the range `minimum` to `maximum` included.
+func RandomDuration(minimum time.Duration, maximum time.Duration) time.Duration {
+	return Default().RandomDuration(minimum, maximum)
+}
+
+// RandomDuration returns a random duration.  See RandomDuration.
+func (g *Generator) RandomDuration(minimum time.Duration, maximum time.Duration) time.Duration {
+	return time.Duration(g.int64Between(int64(minimum), int64(maximum)))
+}
+
+// RandomTimestamp returns a random instant formatted in a random format, and the format.  It accepts
+// the options of RandomTime.  With WithTimeEdges, a leap second may be formatted with the second 60 in
+// the RFC 3339 formats, which time.Parse rejects.
+func RandomTimestamp(opts ...TimeOption) (string, TimeFormat) {
+	return Default().RandomTimestamp(opts...)
+}
+
+// RandomTimestamp returns a random formatted instant and its format.  See RandomTimestamp.
+func (g *Generator) RandomTimestamp(opts ...TimeOption) (string, TimeFormat) {
+	to := collectTimeOptions(opts...)
+	t, leap := g.instant(to)
+	f := to.formats[g.IntN(len(to.formats))]
+	s := FormatTime(t, f)
+	if leap && (f == FormatRFC3339 || f == FormatRFC3339Nano) {
+		// the second following 23:59:59 UTC is the leap second 23:59:60.
+		s = t.Format("2006-01-02T15:04:") + "60" + t.Format("Z07:00")
+	}
+	return s, f
+}
+
+// FormatTime returns `t` formatted in the format `f`.
+func FormatTime(t time.Time, f TimeFormat) string {
+	switch f {
+	case FormatISOWeek:
+		year, week := t.ISOWeek()
+		day := int(t.Weekday())
+		if day == 0 {
+			day = 7
+		}
+		return fmt.Sprintf("%04d-W%02d-%d", year, week, day)
+	case FormatUnix:
+		return strconv.FormatInt(t.Unix(), 10)
+	case FormatUnixMilli:
+		return strconv.FormatInt(t.Unix()*1000+int64(t.Nanosecond()/1e6), 10)
+	default:
+		return t.Format(timeLayouts[f])
+	}
+}
+
+// instant returns a random instant according to the options.  It returns true if the instant is
+// the last nanosecond before a leap second.
+func (g *Generator) instant(to *timeOptions) (time.Time, bool) {
+	loc := to.zones[g.IntN(len(to.zones))]
+	if to.edges > 0 && g.Float64() < to.edges {
+		// a reversed range has no edge.
+		if edges, leaps := timeEdges(to.lo, to.hi); len(edges) > 0 {
+			i := g.IntN(len(edges))
+			return edges[i].In(loc), i < leaps
+		}
+	}
+	t := g.timeBetween(to.lo, to.hi).In(loc)
+	if to.dst <= 0 || g.Float64() >= to.dst {
+		return t, false
+	}
+	// the zone may have no transition in the year following t, thus a few instants are tried.
+	for i := 0; i < maxTries; i++ {
+		if tr, ok := nextTransition(t, to.hi); ok {
+			u := tr.Add(g.RandomDuration(-2*time.Hour, 2*time.Hour))
+			if !u.Before(to.lo) && !u.After(to.hi) {
+				return u, false
+			}
+		}
+		t = g.timeBetween(to.lo, to.hi).In(loc)
+	}
+	return t, false
+}
+
+// timeBetween returns a random instant in the range `lo` to `hi` included.  It returns `lo` if `hi`
+// is not after `lo`.
+func (g *Generator) timeBetween(lo time.Time, hi time.Time) time.Time {
+	if !hi.After(lo) {
+		return lo
+	}
+	t := time.Unix(g.int64Between(lo.Unix(), hi.Unix()), g.Int64N(1e9)).UTC()
+	if t.Before(lo) {
+		return lo.UTC()
+	}
+	if t.After(hi) {
+		return hi.UTC()
+	}
+	return t
+}
+
+// timeEdges returns the edge instants in the range `lo` to `hi`.  The first `leaps` instants are the
+// last nanosecond before a leap second.
+func timeEdges(lo time.Time, hi time.Time) (edges []time.Time, leaps int) {
+	in := func(t time.Time) bool { return !t.Before(lo) && !t.After(hi) }
+	for _, day := range leapSeconds {
+		t, _ := time.Parse("2006-01-02", day)
+		if t = t.Add(24*time.Hour - time.Nanosecond); in(t) {
+			edges = append(edges, t)
+		}
+	}
+	leaps = len(edges)
+	candidates := []time.Time{
+		lo, hi, MinTime, MaxTime, time.Unix(0, 0), time.Unix(-1, 0), time.Unix(1<<31-1, 0),
+		time.Unix(1<<31, 0), time.Unix(-1<<31, 0), time.Date(9999, 12, 31, 23, 59, 59, 999999999, time.UTC),
+		time.Date(2000, 2, 29, 12, 0, 0, 0, time.UTC), time.Date(1900, 2, 28, 23, 59, 59, 0, time.UTC),
+	}
+	for _, year := range []int{lo.Year(), hi.Year(), 2024, 2028} {
+		if year < 1 || year > 9999 {
+			continue
+		}
+		candidates = append(candidates, time.Date(year, 12, 31, 23, 59, 59, 999999999, time.UTC),
+			time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC))
+		if leap := year - year%4; leap%100 != 0 || leap%400 == 0 {
+			candidates = append(candidates, time.Date(leap, 2, 29, 0, 0, 0, 0, time.UTC))
+		}
+	}
+	seen := make(map[time.Time]bool)
+	for _, t := range edges {
+		seen[t] = true
+	}
+	for _, t := range candidates {
+		if t = t.UTC(); in(t) && !seen[t] {
+			seen[t] = true
+			edges = append(edges, t)
+		}
+	}
+	return edges, leaps
+}
+
+// nextTransition returns the first change of the offset of the zone of `t` in the year after `t`,
+// with a precision of a second.  It returns false if there is none before `limit`.
+func nextTransition(t time.Time, limit time.Time) (time.Time, bool) {
+	const step = 24 * time.Hour
+	_, offset := t.Zone()
+	for i := 0; i < 370; i++ {
+		next := t.Add(step)
+		if next.After(limit) {
+			return time.Time{}, false
+		}
+		if _, o := next.Zone(); o != offset {
+			lo, hi := t, next
+			for hi.Sub(lo) > time.Second {
+				mid := lo.Add(hi.Sub(lo) / 2)
+				if _, o := mid.Zone(); o == offset {
+					lo = mid
+				} else {
+					hi = mid
+				}
+			}
+			// the transitions occur on whole seconds.
+			return lo.Truncate(time.Second).Add(time.Second), true
+		}
+		t = next
+	}
+	return time.Time{}, false
+}
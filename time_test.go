@@ -0,0 +1,132 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+	_ "time/tzdata" // the zones of the tests do not depend on the host.
+)
+
+func Test_RandomTime(t *testing.T) {
+	_, assert := Describe(t)
+
+	g := Gen(t)
+	lo, hi := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < loops; i++ {
+		tm := g.RandomTime(WithTimeRange(lo, hi))
+		assert.False(tm.Before(lo) || tm.After(hi), tm)
+		assert.Equal(time.UTC, tm.Location())
+	}
+	for i := 0; i < loops; i++ {
+		tm := g.RandomTime(WithTimeRange(MinTime, MaxTime), WithTimeEdges(0.5))
+		assert.False(tm.Before(MinTime) || tm.After(MaxTime), tm)
+	}
+	tm := g.RandomTime()
+	assert.True(tm.Year() >= 1970 && tm.Year() <= 2100)
+}
+
+func Test_RandomTime_Zones(t *testing.T) {
+	_, assert := Describe(t)
+
+	g := Gen(t)
+	zones := make(map[string]bool)
+	for i := 0; i < loops; i++ {
+		zones[g.RandomTime(WithZones()).Location().String()] = true
+	}
+	assert.Greater(len(zones), 5)
+	assert.Equal("Europe/Paris", g.RandomTime(WithZones("Europe/Paris", "Not/AZone")).Location().String())
+	assert.Equal(time.UTC, g.RandomTime(WithZones("Not/AZone")).Location())
+}
+
+func Test_RandomTime_DST(t *testing.T) {
+	_, assert := Describe(t)
+
+	g := Gen(t)
+	for i := 0; i < loops; i++ {
+		tm := g.RandomTime(WithZones("Europe/Paris"), WithDSTBias(1))
+		_, before := tm.Add(-2 * time.Hour).Zone()
+		_, after := tm.Add(2 * time.Hour).Zone()
+		assert.NotEqual(before, after, tm)
+	}
+	// a zone without DST.
+	lo, hi := time.Unix(1e9, 0), time.Unix(2e9, 0)
+	tm := g.RandomTime(WithZones("Asia/Kolkata"), WithDSTBias(1), WithTimeRange(lo, hi))
+	assert.False(tm.Before(lo) || tm.After(hi))
+}
+
+func Test_RandomTime_Edges(t *testing.T) {
+	_, assert := Describe(t)
+
+	g := Gen(t)
+	lo, hi := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)
+	edges := make(map[time.Time]bool)
+	for i := 0; i < loops; i++ {
+		tm := g.RandomTime(WithTimeRange(lo, hi), WithTimeEdges(1))
+		assert.False(tm.Before(lo) || tm.After(hi), tm)
+		edges[tm] = true
+	}
+	assert.True(edges[time.Date(2016, 2, 29, 0, 0, 0, 0, time.UTC)])
+	assert.True(edges[time.Date(2016, 12, 31, 23, 59, 59, 999999999, time.UTC)])
+	assert.True(edges[lo])
+	assert.True(edges[hi])
+	for i := 0; i < loops; i++ {
+		assert.Equal(hi, g.RandomTime(WithTimeRange(hi, lo), WithTimeEdges(1)))
+	}
+}
+
+func Test_RandomDuration(t *testing.T) {
+	_, assert := Describe(t)
+
+	g := Gen(t)
+	for i := 0; i < loops; i++ {
+		d := g.RandomDuration(-time.Second, time.Hour)
+		assert.True(d >= -time.Second && d <= time.Hour)
+	}
+	assert.Equal(time.Minute, RandomDuration(time.Minute, time.Minute))
+}
+
+func Test_RandomTimestamp(t *testing.T) {
+	_, assert := Describe(t)
+
+	g := Gen(t)
+	formats := make(map[TimeFormat]bool)
+	for i := 0; i < 4*loops; i++ {
+		// time.Parse rejects the numeric zone abbreviations of zones such as Asia/Kathmandu.
+		s, f := g.RandomTimestamp(WithZones("UTC", "Europe/Paris", "America/New_York"))
+		formats[f] = true
+		var err error
+		switch f {
+		case FormatISOWeek:
+			assert.Regexp(`^\d{4}-W\d{2}-[1-7]$`, s)
+		case FormatUnix, FormatUnixMilli:
+			_, err = strconv.ParseInt(s, 10, 64)
+		default:
+			_, err = time.Parse(timeLayouts[f], s)
+		}
+		assert.NoError(err, s)
+	}
+	assert.Len(formats, int(endOfFormats))
+
+	leap := time.Date(2016, 12, 31, 23, 59, 59, 999999999, time.UTC)
+	s, _ := g.RandomTimestamp(WithFormats(FormatRFC3339), WithTimeEdges(1), WithTimeRange(leap, leap))
+	assert.Equal("2016-12-31T23:59:60Z", s)
+	_, err := time.Parse(time.RFC3339, s)
+	assert.Error(err)
+}
+
+func Test_FormatTime(t *testing.T) {
+	_, assert := Describe(t)
+
+	tm := time.Date(2026, 10, 17, 13, 14, 15, 123456789, time.UTC)
+	assert.Equal("2026-W42-6", FormatTime(tm, FormatISOWeek))
+	assert.Equal(strconv.FormatInt(tm.Unix(), 10), FormatTime(tm, FormatUnix))
+	assert.Equal(strconv.FormatInt(tm.UnixNano()/1e6, 10), FormatTime(tm, FormatUnixMilli))
+	assert.Equal("2026-10-17", FormatTime(tm, FormatISODate))
+	assert.True(strings.HasPrefix(FormatTime(tm, FormatRFC3339Nano), "2026-10-17T13:14:15.123456789"))
+	assert.Equal("0001-W01-1", FormatTime(MinTime, FormatISOWeek))
+}
@@ -0,0 +1,346 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// TreeOption allows to parameterize RandomTree.
+type TreeOption func(opts *treeOptions)
+
+type treeOptions struct {
+	depth    int
+	maxDirs  int
+	maxFiles int
+	minSize  int
+	maxSize  int
+	empty    float64 // probability of an empty directory.
+	symlinks float64 // probability of a symbolic link next to a file.
+	hidden   float64 // probability of a hidden entry.
+	long     float64 // probability of a long name.
+}
+
+// WithTreeDepth sets the maximal number of nested directories under the root.  The default is 3.
+func WithTreeDepth(depth int) TreeOption {
+	return func(to *treeOptions) {
+		to.depth = depth
+	}
+}
+
+// WithFanOut sets the maximal number of sub-directories and of files per directory.  The default is
+// 3 sub-directories and 4 files.
+func WithFanOut(dirs int, files int) TreeOption {
+	return func(to *treeOptions) {
+		to.maxDirs, to.maxFiles = dirs, files
+	}
+}
+
+// WithFileSizes sets the range of the size of the files in bytes.  The sizes follow a log-uniform
+// distribution so that small and large files are mixed.  The default is 0 to 64 KiB.
+func WithFileSizes(minimum int, maximum int) TreeOption {
+	return func(to *treeOptions) {
+		to.minSize, to.maxSize = minimum, maximum
+	}
+}
+
+// WithEmptyDirs sets the probability of an empty directory.  The default is 0.1.
+func WithEmptyDirs(rate float64) TreeOption {
+	return func(to *treeOptions) {
+		to.empty = rate
+	}
+}
+
+// WithSymlinks sets the probability of a symbolic link to a file of the same directory.
+// The default is 0.1.
+func WithSymlinks(rate float64) TreeOption {
+	return func(to *treeOptions) {
+		to.symlinks = rate
+	}
+}
+
+// WithHiddenFiles sets the probability of a hidden file or directory, i.e., which name starts with
+// a dot.  The default is 0.1.
+func WithHiddenFiles(rate float64) TreeOption {
+	return func(to *treeOptions) {
+		to.hidden = rate
+	}
+}
+
+// WithLongNames sets the probability of a name of 100 to 200 characters.  The default is 0.05.
+func WithLongNames(rate float64) TreeOption {
+	return func(to *treeOptions) {
+		to.long = rate
+	}
+}
+
+func collectTreeOptions(options ...TreeOption) *treeOptions {
+	opts := &treeOptions{
+		depth:    3,
+		maxDirs:  3,
+		maxFiles: 4,
+		maxSize:  64 * 1024,
+		empty:    0.1,
+		symlinks: 0.1,
+		hidden:   0.1,
+		long:     0.05,
+	}
+	for _, option := range options {
+		option(opts)
+	}
+	return opts
+}
+
+// TreeEntry describes a file, a directory or a symbolic link of a tree.
+type TreeEntry struct {
+	// Path is the slash-separated path relative to the root of the tree.
+	Path string
+	// Size is the size in bytes of a regular file, and zero for a directory.
+	Size int64
+	// Mode holds the type and the permissions of the entry.
+	Mode os.FileMode
+	// SHA256 is the hexadecimal SHA-256 digest of the content of a regular file.
+	SHA256 string
+	// Target is the target of a symbolic link.
+	Target string
+}
+
+// Manifest lists the entries of a tree sorted by path.
+type Manifest []TreeEntry
+
+// RandomTree creates under `root` a random tree of nested directories, files of mixed sizes, empty
+// directories, symbolic links, hidden files and long names.  It returns the manifest of the created
+// entries.  See the TreeOption functions for the parameters of the tree.
+func RandomTree(root string, opts ...TreeOption) (Manifest, error) {
+	return Default().RandomTree(root, opts...)
+}
+
+// RandomTree creates under `root` a random tree.  See RandomTree.
+func (g *Generator) RandomTree(root string, opts ...TreeOption) (Manifest, error) {
+	to := collectTreeOptions(opts...)
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return nil, err
+	}
+	var paths []string
+	if err := g.populate(root, "", to, to.depth, &paths); err != nil {
+		return nil, err
+	}
+	m := make(Manifest, 0, len(paths))
+	for _, p := range paths {
+		e, err := treeEntry(root, p)
+		if err != nil {
+			return nil, err
+		}
+		m = append(m, e)
+	}
+	sort.Slice(m, func(i, j int) bool { return m[i].Path < m[j].Path })
+	return m, nil
+}
+
+// ScanTree returns the manifest of the tree under `root`, the root excluded.  The symbolic links
+// are not followed.
+func ScanTree(root string) (Manifest, error) {
+	var m Manifest
+	err := filepath.Walk(root, func(name string, _ os.FileInfo, err error) error {
+		if err != nil || name == root {
+			return err
+		}
+		rel, err := filepath.Rel(root, name)
+		if err != nil {
+			return err
+		}
+		e, err := treeEntry(root, filepath.ToSlash(rel))
+		if err != nil {
+			return err
+		}
+		m = append(m, e)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(m, func(i, j int) bool { return m[i].Path < m[j].Path })
+	return m, nil
+}
+
+// Find returns the entry of the manifest with the path `p`.
+func (m Manifest) Find(p string) (TreeEntry, bool) {
+	i := sort.Search(len(m), func(i int) bool { return m[i].Path >= p })
+	if i < len(m) && m[i].Path == p {
+		return m[i], true
+	}
+	return TreeEntry{}, false
+}
+
+// Diff returns the sorted paths of the entries that are missing in one of the manifests or that
+// differ.  It returns nil if both manifests are identical.
+func (m Manifest) Diff(other Manifest) []string {
+	var diffs []string
+	i, j := 0, 0
+	for i < len(m) || j < len(other) {
+		switch {
+		case j == len(other) || (i < len(m) && m[i].Path < other[j].Path):
+			diffs = append(diffs, m[i].Path)
+			i++
+		case i == len(m) || other[j].Path < m[i].Path:
+			diffs = append(diffs, other[j].Path)
+			j++
+		default:
+			if m[i] != other[j] {
+				diffs = append(diffs, m[i].Path)
+			}
+			i++
+			j++
+		}
+	}
+	return diffs
+}
+
+// populate fills the directory `rel` of the tree `root` and records the created paths in `paths`.
+func (g *Generator) populate(root string, rel string, to *treeOptions, depth int, paths *[]string) error {
+	if rel != "" && g.Float64() < to.empty {
+		return nil
+	}
+	used := make(map[string]bool)
+	var files []string
+	for n := g.between(1, to.maxFiles); n > 0; n-- {
+		p := joinSlash(rel, g.treeName(to, used))
+		if err := g.treeFile(filepath.Join(root, filepath.FromSlash(p)), to); err != nil {
+			return err
+		}
+		files = append(files, p)
+		*paths = append(*paths, p)
+	}
+	for _, f := range files {
+		if g.Float64() >= to.symlinks {
+			continue
+		}
+		p := joinSlash(rel, g.treeName(to, used))
+		if err := os.Symlink(filepath.Base(f), filepath.Join(root, filepath.FromSlash(p))); err != nil {
+			return err
+		}
+		*paths = append(*paths, p)
+	}
+	if depth <= 0 {
+		return nil
+	}
+	minDirs := 0
+	if rel == "" {
+		minDirs = 1 // the root has at least one sub-directory.
+	}
+	for n := g.between(minDirs, to.maxDirs); n > 0; n-- {
+		p := joinSlash(rel, g.treeName(to, used))
+		if err := os.Mkdir(filepath.Join(root, filepath.FromSlash(p)), 0755); err != nil {
+			return err
+		}
+		*paths = append(*paths, p)
+		if err := g.populate(root, p, to, depth-1, paths); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// treeName returns a random file name not yet in `used`.
+func (g *Generator) treeName(to *treeOptions, used map[string]bool) string {
+	const shortMax, longMin, longMax = 12, 100, 200
+	for {
+		var name string
+		if g.Float64() < to.long {
+			name = g.RandomAlphaString(g.between(longMin, longMax), AlphaNumNoSpace)
+		} else {
+			name = g.RandomAlphaString(g.between(1, shortMax), AlphaNumNoSpace)
+		}
+		if g.Float64() < to.hidden {
+			name = "." + name
+		}
+		// some file systems are case-insensitive.
+		if key := strings.ToLower(name); !used[key] {
+			used[key] = true
+			return name
+		}
+	}
+}
+
+// treeFile creates the file `name` with random content and permissions.
+func (g *Generator) treeFile(name string, to *treeOptions) error {
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	if _, err = io.CopyN(f, g, int64(g.logUniform(to.minSize, to.maxSize))); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	modes := []os.FileMode{0644, 0644, 0600, 0755}
+	return os.Chmod(name, modes[g.IntN(len(modes))])
+}
+
+// logUniform returns a random number in the range `minimum` to `maximum` included, with a
+// log-uniform distribution.
+func (g *Generator) logUniform(minimum int, maximum int) int {
+	if maximum <= minimum {
+		return minimum
+	}
+	lo, hi := math.Log(float64(minimum)+1), math.Log(float64(maximum)+1)
+	n := int(math.Exp(lo+g.Float64()*(hi-lo))) - 1
+	if n < minimum {
+		return minimum
+	}
+	if n > maximum {
+		return maximum
+	}
+	return n
+}
+
+// treeEntry returns the entry of the slash-separated path `p` of the tree `root`.
+func treeEntry(root string, p string) (TreeEntry, error) {
+	name := filepath.Join(root, filepath.FromSlash(p))
+	fi, err := os.Lstat(name)
+	if err != nil {
+		return TreeEntry{}, err
+	}
+	e := TreeEntry{Path: p, Mode: fi.Mode()}
+	switch {
+	case fi.Mode()&os.ModeSymlink != 0:
+		e.Target, err = os.Readlink(name)
+	case fi.Mode().IsRegular():
+		e.Size = fi.Size()
+		e.SHA256, err = fileDigest(name)
+	}
+	return e, err
+}
+
+// fileDigest returns the hexadecimal SHA-256 digest of the file `name`.
+func fileDigest(name string) (string, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = f.Close() }()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// joinSlash joins the slash-separated path `dir` and `name`.
+func joinSlash(dir string, name string) string {
+	if dir == "" {
+		return name
+	}
+	return dir + "/" + name
+}
@@ -0,0 +1,90 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func Test_RandomTree(t *testing.T) {
+	require, assert := Describe(t)
+
+	root := filepath.Join(t.TempDir(), "tree")
+	m, err := Gen(t).RandomTree(root, WithTreeDepth(4), WithFanOut(3, 5), WithFileSizes(0, 4096),
+		WithSymlinks(1), WithHiddenFiles(0.3), WithLongNames(0.2), WithEmptyDirs(0.3))
+	require.NoError(err)
+	require.NotEmpty(m)
+
+	scanned, err := ScanTree(root)
+	require.NoError(err)
+	assert.Equal(m, scanned)
+	assert.Nil(m.Diff(scanned))
+
+	var files, dirs, links int
+	for _, e := range m {
+		assert.True(strings.Count(e.Path, "/") <= 4, e.Path)
+		switch {
+		case e.Mode.IsDir():
+			dirs++
+			assert.Empty(e.SHA256)
+		case e.Mode&os.ModeSymlink != 0:
+			links++
+			_, ok := m.Find(filepath.ToSlash(filepath.Join(filepath.Dir(e.Path), e.Target)))
+			assert.True(ok, e.Target)
+		default:
+			files++
+			assert.True(e.Size >= 0 && e.Size <= 4096)
+			assert.Len(e.SHA256, 64)
+		}
+	}
+	assert.NotZero(files)
+	assert.NotZero(dirs)
+	assert.NotZero(links)
+
+	e := m[0]
+	_, ok := m.Find(e.Path)
+	assert.True(ok)
+	_, ok = m.Find("not/there")
+	assert.False(ok)
+}
+
+func Test_Manifest_Diff(t *testing.T) {
+	require, assert := Describe(t)
+
+	root := t.TempDir()
+	m, err := Gen(t).RandomTree(root, WithSymlinks(0), WithEmptyDirs(0), WithFileSizes(1, 100))
+	require.NoError(err)
+	var file TreeEntry
+	for _, e := range m {
+		if e.Mode.IsRegular() {
+			file = e
+			break
+		}
+	}
+	require.NoError(os.WriteFile(filepath.Join(root, filepath.FromSlash(file.Path)), []byte("changed"), 0600))
+	require.NoError(os.WriteFile(filepath.Join(root, "extra"), nil, 0600))
+	scanned, err := ScanTree(root)
+	require.NoError(err)
+	diffs := m.Diff(scanned)
+	assert.Contains(diffs, file.Path)
+	assert.Contains(diffs, "extra")
+	assert.Len(diffs, 2)
+
+	_, err = RandomTree(filepath.Join(root, file.Path, "sub"))
+	assert.Error(err)
+}
+
+func Test_RandomTree_Reproducible(t *testing.T) {
+	require, assert := Describe(t)
+
+	m1, err := NewGenerator(42).RandomTree(t.TempDir())
+	require.NoError(err)
+	m2, err := NewGenerator(42).RandomTree(t.TempDir())
+	require.NoError(err)
+	assert.Equal(m1, m2)
+}
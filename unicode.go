@@ -0,0 +1,223 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// SizeUnit defines what the size of a generated string counts.
+type SizeUnit int
+
+const (
+	// Runes requests that the size counts runes.  An invalid UTF-8 byte counts as one rune.
+	Runes SizeUnit = iota
+	// Bytes requests that the size counts bytes.
+	Bytes
+)
+
+const (
+	// maxTries is the number of attempts to find an element that fits in the remaining size.
+	maxTries = 8
+	// letters are the Latin letters used by the Unicode character sets.
+	letters = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+)
+
+var (
+	// unicodeSets generates one element of each Unicode character set.  An element may hold
+	// several runes, such as an emoji ZWJ sequence or a letter with its combining marks.
+	unicodeSets = map[AlphaNumType]func(g *Generator) string{
+		MultiByte:   func(g *Generator) string { return g.pickString(multiBytes) },
+		Emoji:       func(g *Generator) string { return g.pickString(emojis) },
+		Combining:   combining,
+		RTL:         func(g *Generator) string { return g.pickString(rtls) },
+		ZeroWidth:   zeroWidth,
+		InvalidUTF8: invalidUTF8,
+	}
+
+	multiBytes = concat(
+		runeRange(0xC0, 0xD6), runeRange(0xD8, 0xF6), runeRange(0xF8, 0xFF), // Latin-1
+		runeRange(0x391, 0x3A1), runeRange(0x3A3, 0x3A9), runeRange(0x3B1, 0x3C9), // Greek
+		runeRange(0x410, 0x44F),     // Cyrillic
+		runeRange(0x4E00, 0x4E5F),   // CJK
+		runeRange(0xAC00, 0xAC3F),   // Hangul
+		runeRange(0x10400, 0x1044F), // Deseret
+		runeRange(0x1D400, 0x1D433), // mathematical bold
+		runeRange(0x20000, 0x2001F), // CJK extension B
+	)
+
+	rtls = concat(
+		runeRange(0x5D0, 0x5EA), // Hebrew
+		runeRange(0x627, 0x64A), // Arabic
+		runeRange(0x660, 0x669), // Arabic-Indic digits
+		[]string{" "},
+	)
+
+	emojis = concat(
+		runeRange(0x1F600, 0x1F64F),
+		runeRange(0x1F300, 0x1F35F),
+		[]string{
+			"\U0001F44D\U0001F3FD", // thumbs up, medium skin tone
+			"\U0001F468\u200D\U0001F469\u200D\U0001F467\u200D\U0001F466", // family
+			"\U0001F469\u200D\U0001F4BB",                                 // woman technologist
+			"\U0001F3F3\uFE0F\u200D\U0001F308",                           // rainbow flag
+			"\U0001F9D1\u200D\U0001F91D\u200D\U0001F9D1",                 // people holding hands
+			"\U0001F1EB\U0001F1F7",                                       // flag of France
+			"\U0001F1EF\U0001F1F5",                                       // flag of Japan
+			"1\uFE0F\u20E3",                                              // keycap one
+			"\u2764\uFE0F",                                               // red heart
+		},
+	)
+
+	// zeroWidths are ZWSP, ZWNJ, ZWJ, word joiner, BOM, LRM and RLM.
+	zeroWidths = []string{"\u200B", "\u200C", "\u200D", "\u2060", "\uFEFF", "\u200E", "\u200F"}
+
+	invalidSequences = []string{
+		"\x80",             // lone continuation byte
+		"\xbf",             // lone continuation byte
+		"\xfe",             // never valid
+		"\xff",             // never valid
+		"\xc0\x80",         // overlong NUL
+		"\xc1\xbf",         // overlong
+		"\xe0\x80\xaf",     // overlong '/'
+		"\xed\xa0\x80",     // surrogate half
+		"\xf4\x90\x80\x80", // beyond U+10FFFF
+		"\xe2\x82z",        // truncated, the letter prevents completion by the next element
+		"\xf0\x9f\x98z",    // truncated, the letter prevents completion by the next element
+	}
+)
+
+// RandomAlphaStringSized generates a random string of the character set `t` which size is
+// `size` in `unit`.  If size is zero or negative, then the size is random in the range 1 to 256.
+//
+// When no element of a Unicode character set fits in the remaining space, for instance a
+// 4-byte letter when only one byte remains, the string is completed with lower case ASCII letters.
+// The same applies to the registered character sets holding non-ASCII characters.
+func RandomAlphaStringSized(size int, unit SizeUnit, t AlphaNumType) string {
+	return Default().RandomAlphaStringSized(size, unit, t)
+}
+
+// RandomAlphaStringSized generates a random string of the character set `t` which size is
+// `size` in `unit`.  See RandomAlphaStringSized.
+func (g *Generator) RandomAlphaStringSized(size int, unit SizeUnit, t AlphaNumType) string {
+	const size0 = 256 // max size for random set.
+	if size <= 0 {
+		size = g.IntN(size0) + 1
+	}
+	if _, ok := unicodeSets[t]; ok {
+		return g.unicodeString(size, unit, t)
+	}
+	c, ok := CharsetOf(t)
+	if !ok || c.Len() == 0 {
+		return ""
+	}
+	if unit == Runes || c.ascii {
+		return g.RandomCharsetString(size, c)
+	}
+	next := func(g *Generator) string { return string(c.pick(g)) }
+	return g.fill(size, unit, next, next)
+}
+
+// unicodeString generates a string of the Unicode character set `t` of `size` `unit`.
+func (g *Generator) unicodeString(size int, unit SizeUnit, t AlphaNumType) string {
+	next := unicodeSets[t]
+	first := next
+	if t == InvalidUTF8 {
+		// guarantees at least one invalid sequence.
+		first = func(g *Generator) string {
+			if e := g.pickString(invalidSequences); measure(unit, e) <= size {
+				return e
+			}
+			return "\xff"
+		}
+	}
+	return g.fill(size, unit, first, next)
+}
+
+// fill generates a string of `size` `unit` with a first element drawn by `first` and the
+// other ones by `next`.  When no element fits in the remaining size, it completes with lower case
+// ASCII letters.
+func (g *Generator) fill(size int, unit SizeUnit, first func(*Generator) string, next func(*Generator) string) string {
+	var sb strings.Builder
+	element := first
+	for remaining := size; remaining > 0; {
+		e := element(g)
+		for i := 0; measure(unit, e) > remaining && i < maxTries; i++ {
+			e = element(g)
+		}
+		if measure(unit, e) > remaining {
+			e = g.RandomAlphaString(remaining, Small)
+		}
+		sb.WriteString(e)
+		remaining -= measure(unit, e)
+		element = next
+	}
+	return sb.String()
+}
+
+// measure returns the size of `s` in `unit`.
+func measure(unit SizeUnit, s string) int {
+	if unit == Bytes {
+		return len(s)
+	}
+	return utf8.RuneCountInString(s)
+}
+
+// pickString returns a random element of `list`.
+func (g *Generator) pickString(list []string) string {
+	return list[g.IntN(len(list))]
+}
+
+// letter returns a random Latin letter.
+func (g *Generator) letter() string {
+	i := g.IntN(len(letters))
+	return letters[i : i+1]
+}
+
+// combining returns a Latin letter followed by one to three combining diacritical marks.
+func combining(g *Generator) string {
+	const first, last = 0x300, 0x36F
+	const maxMarks = 3
+	s := g.letter()
+	for i := g.IntN(maxMarks) + 1; i > 0; i-- {
+		s += string(rune(first + g.IntN(last-first+1)))
+	}
+	return s
+}
+
+// zeroWidth returns either a Latin letter or a zero-width character.
+func zeroWidth(g *Generator) string {
+	if g.IntN(2) == 0 {
+		return g.pickString(zeroWidths)
+	}
+	return g.letter()
+}
+
+// invalidUTF8 returns either a Latin letter or an invalid UTF-8 sequence.
+func invalidUTF8(g *Generator) string {
+	if g.IntN(2) == 0 {
+		return g.pickString(invalidSequences)
+	}
+	return g.letter()
+}
+
+// runeRange returns the runes from `first` to `last` included, each as a string.
+func runeRange(first rune, last rune) []string {
+	list := make([]string, 0, last-first+1)
+	for r := first; r <= last; r++ {
+		list = append(list, string(r))
+	}
+	return list
+}
+
+// concat concatenates the lists.
+func concat(lists ...[]string) []string {
+	var all []string
+	for _, l := range lists {
+		all = append(all, l...)
+	}
+	return all
+}
@@ -0,0 +1,48 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"strings"
+	"testing"
+	"unicode"
+	"unicode/utf8"
+)
+
+func Test_RandomAlphaString_Unicode(t *testing.T) {
+	_, assert := Describe(t)
+
+	g := Gen(t)
+	for _, ty := range []AlphaNumType{MultiByte, Emoji, Combining, RTL, ZeroWidth, InvalidUTF8} {
+		for i := 1; i < 50; i++ {
+			s := g.RandomAlphaString(i, ty)
+			assert.Equal(i, utf8.RuneCountInString(s), "type %d", ty)
+			s = g.RandomAlphaStringSized(i, Bytes, ty)
+			assert.Equal(i, len(s), "type %d", ty)
+			assert.Equal(ty != InvalidUTF8, utf8.ValidString(s), "type %d", ty)
+		}
+	}
+	s := g.RandomAlphaString(200, MultiByte)
+	assert.Greater(len(s), 200)
+	assert.Contains(g.RandomAlphaStringSized(300, Runes, Emoji), "‍")
+	assert.True(strings.ContainsFunc(g.RandomAlphaString(50, Combining), func(r rune) bool {
+		return unicode.Is(unicode.Mn, r)
+	}))
+	assert.Equal(20, len(g.RandomAlphaStringSized(20, Bytes, Alpha)))
+}
+
+func Test_SwapCase_Unicode(t *testing.T) {
+	_, assert := Describe(t)
+
+	g := Gen(t)
+	s := "ΑΒΓΔΕαβγδεАБВГДабвгд" + string(rune(0x10400)) + string(rune(0x10428))
+	s1 := g.SwapCase(s)
+	assert.NotEqual(s, s1)
+	assert.Equal(strings.ToLower(s), strings.ToLower(s1))
+	s = g.RandomAlphaString(50, InvalidUTF8)
+	s1 = g.SwapCase(s)
+	assert.False(utf8.ValidString(s1))
+	assert.Equal(len(s), len(s1))
+}
@@ -0,0 +1,357 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"encoding/xml"
+	"fmt"
+	"html"
+	"strings"
+)
+
+// XMLFlaw is a well-formedness error of a document generated by RandomMalformedXML.
+type XMLFlaw int
+
+const (
+	// UnclosedTag omits the end tag of an element.
+	UnclosedTag XMLFlaw = iota + 1
+	// MismatchedTag ends an element with the end tag of another name.
+	MismatchedTag
+	// BadEntity inserts an undefined entity, an entity without semicolon or a bare ampersand.
+	BadEntity
+	// InvalidChar inserts a control character, a non-character or invalid UTF-8.
+	InvalidChar
+	// UnquotedAttribute adds an attribute which value has no quotes.
+	UnquotedAttribute
+	// EntityExpansion declares nested entities in the DTD which expand to about 3 GB, as in the
+	// "billion laughs" attack.  The document is well-formed but exhausts the parsers that expand
+	// the entities without limit.
+	EntityExpansion
+)
+
+// xmlKind is the kind of an XML node.
+type xmlKind int
+
+const (
+	xmlElement xmlKind = iota
+	xmlText
+	xmlCDATA
+	xmlComment
+)
+
+// xmlNode is a node of a generated document.
+type xmlNode struct {
+	kind     xmlKind
+	name     string
+	attrs    [][2]string
+	text     string
+	children []*xmlNode
+	void     bool // true for an HTML void element.
+	flaw     XMLFlaw
+}
+
+// htmlElements lists the elements of the HTML subset with their possible attribute.
+var htmlElements = [][2]string{
+	{"div", "class"}, {"p", "class"}, {"span", "title"}, {"a", "href"}, {"em", ""}, {"strong", ""},
+	{"ul", ""}, {"ol", ""}, {"li", ""}, {"h1", "id"}, {"h2", "id"}, {"blockquote", "cite"},
+	{"table", ""}, {"tr", ""}, {"td", "class"}, {"pre", ""}, {"code", ""}, {"section", "id"},
+}
+
+// htmlVoids lists the void elements of the HTML subset and their attribute.
+var htmlVoids = [][2]string{{"br", ""}, {"hr", ""}, {"img", "src"}, {"input", "value"}}
+
+// htmlEntities lists named entities inserted in the HTML text.
+var htmlEntities = []string{"&nbsp;", "&copy;", "&eacute;", "&mdash;", "&laquo;", "&raquo;", "&euro;"}
+
+// XMLOption allows to parameterize RandomXML, RandomHTML and RandomMalformedXML.
+type XMLOption func(opts *xmlOptions)
+
+type xmlOptions struct {
+	depth   int
+	breadth int
+}
+
+// WithXMLDepth sets the maximal depth of the nested elements below the root.  The default is 3.
+func WithXMLDepth(depth int) XMLOption {
+	return func(xo *xmlOptions) {
+		xo.depth = depth
+	}
+}
+
+// WithXMLBreadth sets the maximal number of children of an element.  The default is 4.
+func WithXMLBreadth(breadth int) XMLOption {
+	return func(xo *xmlOptions) {
+		xo.breadth = breadth
+	}
+}
+
+func collectXMLOptions(options ...XMLOption) *xmlOptions {
+	opts := &xmlOptions{depth: 3, breadth: 4}
+	for _, option := range options {
+		option(opts)
+	}
+	return opts
+}
+
+// RandomXML returns a random well-formed XML document.  It has a prolog, namespaces, nested
+// elements with attributes, text with escaped characters and character references, CDATA sections
+// and comments.
+func RandomXML(opts ...XMLOption) []byte {
+	return Default().RandomXML(opts...)
+}
+
+// RandomXML returns a random well-formed XML document.  See RandomXML.
+func (g *Generator) RandomXML(opts ...XMLOption) []byte {
+	root := g.xmlTree(collectXMLOptions(opts...))
+	var sb strings.Builder
+	sb.WriteString(xml.Header)
+	g.writeXML(&sb, root)
+	return []byte(sb.String())
+}
+
+// RandomHTML returns a random HTML document of a DTD-less subset: common elements, void elements
+// without end tag, named entities and comments.
+func RandomHTML(opts ...XMLOption) []byte {
+	return Default().RandomHTML(opts...)
+}
+
+// RandomHTML returns a random HTML document.  See RandomHTML.
+func (g *Generator) RandomHTML(opts ...XMLOption) []byte {
+	xo := collectXMLOptions(opts...)
+	body := &xmlNode{name: "body"}
+	body.children = g.htmlChildren(xo.depth, xo)
+	var sb strings.Builder
+	sb.WriteString("<!DOCTYPE html>\n<html lang=\"en\">\n<head><meta charset=\"utf-8\"><title>")
+	sb.WriteString(html.EscapeString(g.RandomText(WithWords(1, 4))))
+	sb.WriteString("</title></head>\n")
+	g.writeHTML(&sb, body)
+	sb.WriteString("\n</html>\n")
+	return []byte(sb.String())
+}
+
+// RandomMalformedXML returns a random XML document with the well-formedness error `flaw`.  It is
+// meant for testing the error paths of parsers.
+func RandomMalformedXML(flaw XMLFlaw, opts ...XMLOption) []byte {
+	return Default().RandomMalformedXML(flaw, opts...)
+}
+
+// RandomMalformedXML returns a random XML document with the error `flaw`.  See RandomMalformedXML.
+func (g *Generator) RandomMalformedXML(flaw XMLFlaw, opts ...XMLOption) []byte {
+	root := g.xmlTree(collectXMLOptions(opts...))
+	var sb strings.Builder
+	sb.WriteString(xml.Header)
+	if flaw == EntityExpansion {
+		const levels = 10
+		fmt.Fprintf(&sb, "<!DOCTYPE %s [\n  <!ENTITY lol0 \"lol\">\n", root.name)
+		for i := 1; i < levels; i++ {
+			fmt.Fprintf(&sb, "  <!ENTITY lol%d \"%s\">\n", i, strings.Repeat(fmt.Sprintf("&lol%d;", i-1), 10))
+		}
+		sb.WriteString("]>\n")
+		root.children = append(root.children, &xmlNode{kind: xmlText, text: fmt.Sprintf("&lol%d;", levels-1)})
+	} else {
+		var elements []*xmlNode
+		root.elements(&elements)
+		elements[g.IntN(len(elements))].flaw = flaw
+	}
+	g.writeXML(&sb, root)
+	return []byte(sb.String())
+}
+
+// xmlTree returns a random element tree with namespaces declared by the root.
+func (g *Generator) xmlTree(xo *xmlOptions) *xmlNode {
+	var prefixes []string
+	root := &xmlNode{name: g.xmlName()}
+	root.attrs = append(root.attrs, [2]string{"xmlns", "urn:test:" + g.xmlName()})
+	for i := g.IntN(3); i > 0; i-- {
+		p := g.xmlName()
+		if len(prefixes) != 0 && prefixes[0] == p {
+			continue
+		}
+		prefixes = append(prefixes, p)
+		root.attrs = append(root.attrs, [2]string{"xmlns:" + p, "http://example.com/" + g.xmlName()})
+	}
+	g.xmlFill(root, xo.depth, xo, prefixes)
+	return root
+}
+
+// xmlFill adds random attributes and children to the element `e`, up to `depth` levels.
+func (g *Generator) xmlFill(e *xmlNode, depth int, xo *xmlOptions, prefixes []string) {
+	prefixed := func(name string) string {
+		if len(prefixes) != 0 && g.IntN(4) == 0 {
+			return g.pickString(prefixes) + ":" + name
+		}
+		return name
+	}
+	used := map[string]bool{}
+	for i := g.IntN(4); i > 0; i-- {
+		name := prefixed(g.xmlName())
+		if !used[name] {
+			used[name] = true
+			e.attrs = append(e.attrs, [2]string{name, g.xmlText()})
+		}
+	}
+	for i := g.between(0, xo.breadth); i > 0; i-- {
+		switch n := g.IntN(10); {
+		case n < 5 && depth > 0:
+			child := &xmlNode{name: prefixed(g.xmlName())}
+			g.xmlFill(child, depth-1, xo, prefixes)
+			e.children = append(e.children, child)
+		case n < 8:
+			e.children = append(e.children, &xmlNode{kind: xmlText, text: g.xmlText()})
+		case n < 9:
+			// a CDATA section cannot hold its end delimiter.
+			text := strings.ReplaceAll(g.xmlText()+"<&>", "]]>", "]] >")
+			e.children = append(e.children, &xmlNode{kind: xmlCDATA, text: text})
+		default:
+			e.children = append(e.children, &xmlNode{kind: xmlComment, text: g.commentText()})
+		}
+	}
+}
+
+// htmlChildren returns random HTML nodes, nested up to `depth` levels.
+func (g *Generator) htmlChildren(depth int, xo *xmlOptions) []*xmlNode {
+	children := make([]*xmlNode, 0, xo.breadth)
+	for i := g.between(1, xo.breadth); i > 0; i-- {
+		switch n := g.IntN(10); {
+		case n < 5 && depth > 0:
+			def := htmlElements[g.IntN(len(htmlElements))]
+			e := &xmlNode{name: def[0]}
+			if def[1] != "" && g.IntN(2) == 0 {
+				e.attrs = [][2]string{{def[1], g.RandomText(WithWords(1, 3))}}
+			}
+			e.children = g.htmlChildren(depth-1, xo)
+			children = append(children, e)
+		case n < 6:
+			def := htmlVoids[g.IntN(len(htmlVoids))]
+			e := &xmlNode{name: def[0], void: true}
+			if def[1] != "" {
+				e.attrs = [][2]string{{def[1], g.RandomText(WithWords(1, 3))}}
+			}
+			children = append(children, e)
+		case n < 9:
+			children = append(children, &xmlNode{kind: xmlText, text: g.htmlText()})
+		default:
+			children = append(children, &xmlNode{kind: xmlComment, text: g.commentText()})
+		}
+	}
+	return children
+}
+
+// elements appends to `list` the element `e` and its descendant elements.
+func (e *xmlNode) elements(list *[]*xmlNode) {
+	if e.kind != xmlElement {
+		return
+	}
+	*list = append(*list, e)
+	for _, c := range e.children {
+		c.elements(list)
+	}
+}
+
+// writeXML writes the node `n` and its descendants, with its flaw if any.
+func (g *Generator) writeXML(sb *strings.Builder, n *xmlNode) {
+	switch n.kind {
+	case xmlText:
+		sb.WriteString(n.text)
+		return
+	case xmlCDATA:
+		sb.WriteString("<![CDATA[" + n.text + "]]>")
+		return
+	case xmlComment:
+		sb.WriteString("<!--" + n.text + "-->")
+		return
+	}
+	sb.WriteString("<" + n.name)
+	for _, a := range n.attrs {
+		sb.WriteString(" " + a[0] + "=\"" + a[1] + "\"")
+	}
+	if n.flaw == UnquotedAttribute {
+		sb.WriteString(" " + g.xmlName() + "=" + g.xmlName())
+	}
+	if len(n.children) == 0 && n.flaw == 0 && g.IntN(2) == 0 {
+		sb.WriteString("/>")
+		return
+	}
+	sb.WriteString(">")
+	switch n.flaw {
+	case BadEntity:
+		// the prefix "u" excludes the predefined entities lt, gt, amp, quot and apos.
+		sb.WriteString(g.pickString([]string{"&u" + g.xmlName() + ";", "AT&T ", "&amp", "a & b"}))
+	case InvalidChar:
+		sb.WriteString(g.pickString([]string{"\x00", "\x01", "\x1b", "\x7f\x08", "\ufffe", "\xff\xfe"}))
+	}
+	for _, c := range n.children {
+		g.writeXML(sb, c)
+	}
+	switch n.flaw {
+	case UnclosedTag:
+	case MismatchedTag:
+		sb.WriteString("</" + n.name + "x>")
+	default:
+		sb.WriteString("</" + n.name + ">")
+	}
+}
+
+// writeHTML writes the HTML node `n` and its descendants.
+func (g *Generator) writeHTML(sb *strings.Builder, n *xmlNode) {
+	switch n.kind {
+	case xmlText:
+		sb.WriteString(n.text)
+		return
+	case xmlComment:
+		sb.WriteString("<!--" + n.text + "-->")
+		return
+	}
+	sb.WriteString("<" + n.name)
+	for _, a := range n.attrs {
+		sb.WriteString(" " + a[0] + "=\"" + html.EscapeString(a[1]) + "\"")
+	}
+	sb.WriteString(">")
+	if n.void {
+		return
+	}
+	for _, c := range n.children {
+		g.writeHTML(sb, c)
+	}
+	sb.WriteString("</" + n.name + ">")
+}
+
+// xmlName returns a random lower case name which does not start with the reserved "xml".
+func (g *Generator) xmlName() string {
+	name := strings.ToLower(g.RandomName(g.between(1, 8)))
+	if strings.HasPrefix(name, "xml") {
+		name = "x" + name[3:]
+	}
+	return name
+}
+
+// xmlText returns a random escaped text with markup characters, non-ASCII characters and
+// character references.
+func (g *Generator) xmlText() string {
+	text := g.RandomText(WithWords(1, 6))
+	if g.IntN(2) == 0 {
+		text += " " + g.pickString([]string{"<", ">", "&", "\"", "'", "]]>"}) + g.RandomAlphaString(2, MultiByte)
+	}
+	var sb strings.Builder
+	_ = xml.EscapeText(&sb, []byte(text))
+	if g.IntN(4) == 0 {
+		fmt.Fprintf(&sb, " &#x%X;&#%d;", 0x263A, 0xE9)
+	}
+	return sb.String()
+}
+
+// htmlText returns a random escaped HTML text with named entities.
+func (g *Generator) htmlText() string {
+	text := html.EscapeString(g.RandomText(WithWords(1, 8)))
+	if g.IntN(2) == 0 {
+		text += " " + g.pickString(htmlEntities) + " " + html.EscapeString(g.pickString([]string{"<", ">", "&", "\""}))
+	}
+	return text
+}
+
+// commentText returns a random comment text, which cannot contain "--" nor end with "-".
+func (g *Generator) commentText() string {
+	return " " + strings.ReplaceAll(g.RandomText(WithWords(1, 6)), "-", "") + " "
+}
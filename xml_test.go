@@ -0,0 +1,93 @@
+// v0.1.0
+// Author: DIEHL E.
+// © Oct 2026
+
+package test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"testing"
+)
+
+// parseXML returns the maximal depth of the document `data` and the first error of the decoder.
+func parseXML(data []byte, htmlMode bool) (int, error) {
+	d := xml.NewDecoder(bytes.NewReader(data))
+	if htmlMode {
+		d.Strict, d.AutoClose, d.Entity = false, xml.HTMLAutoClose, xml.HTMLEntity
+	}
+	depth, maxDepth := 0, 0
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			return maxDepth, nil
+		}
+		if err != nil {
+			return maxDepth, err
+		}
+		switch tok.(type) {
+		case xml.StartElement:
+			if depth++; depth > maxDepth {
+				maxDepth = depth
+			}
+		case xml.EndElement:
+			depth--
+		}
+	}
+}
+
+func Test_RandomXML(t *testing.T) {
+	require, assert := Describe(t)
+
+	g := Gen(t)
+	features := map[string]bool{}
+	for i := 0; i < loops; i++ {
+		data := g.RandomXML(WithXMLDepth(2), WithXMLBreadth(3))
+		require.True(bytes.HasPrefix(data, []byte(xml.Header)))
+		depth, err := parseXML(data, false)
+		require.NoError(err, string(data))
+		assert.LessOrEqual(depth, 3)
+		for _, f := range []string{"<![CDATA[", "<!--", "xmlns:", "&amp;", "&#x263A;"} {
+			features[f] = features[f] || bytes.Contains(data, []byte(f))
+		}
+		var v struct{}
+		require.NoError(xml.Unmarshal(data, &v))
+	}
+	assert.Len(features, 5)
+	for f, found := range features {
+		assert.True(found, f)
+	}
+	depth, err := parseXML(g.RandomXML(WithXMLDepth(0)), false)
+	require.NoError(err)
+	assert.Equal(1, depth)
+}
+
+func Test_RandomHTML(t *testing.T) {
+	require, assert := Describe(t)
+
+	g := Gen(t)
+	for i := 0; i < loops; i++ {
+		data := g.RandomHTML()
+		require.True(bytes.HasPrefix(data, []byte("<!DOCTYPE html>")))
+		_, err := parseXML(data, true)
+		require.NoError(err, string(data))
+		assert.Contains(string(data), "<body>")
+	}
+}
+
+func Test_RandomMalformedXML(t *testing.T) {
+	require, assert := Describe(t)
+
+	g := Gen(t)
+	for _, flaw := range []XMLFlaw{UnclosedTag, MismatchedTag, BadEntity, InvalidChar, UnquotedAttribute} {
+		for i := 0; i < loops; i++ {
+			data := g.RandomMalformedXML(flaw)
+			_, err := parseXML(data, false)
+			require.Error(err, "%d: %s", flaw, data)
+		}
+	}
+	data := g.RandomMalformedXML(EntityExpansion)
+	assert.Contains(string(data), "<!ENTITY lol9 \"&lol8;&lol8;")
+	assert.Contains(string(data), "&lol9;</")
+}